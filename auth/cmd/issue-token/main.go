@@ -0,0 +1,40 @@
+// Command issue-token prints a token signed with AUTH_TOKEN_SECRET, for operators and local testing.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/szbobrowski/master-thesis/auth"
+)
+
+func main() {
+	subject := flag.String("sub", "", "identyfikator użytkownika")
+	roleName := flag.String("role", "", "rola: dispatcher, lifeguard, supervisor, admin, public_reporter lub service")
+	lifeguardID := flag.Int64("lifeguard-id", 0, "id ratownika, wymagane dla roli lifeguard")
+	ttl := flag.Duration("ttl", 8*time.Hour, "czas ważności tokenu")
+	flag.Parse()
+
+	role, err := auth.ParseRole(*roleName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *subject == "" {
+		log.Fatal("Podaj identyfikator użytkownika w parametrze -sub")
+	}
+	if role == auth.RoleLifeguard && *lifeguardID <= 0 {
+		log.Fatal("Token roli lifeguard wymaga parametru -lifeguard-id")
+	}
+
+	signer, err := auth.SignerFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	token, err := signer.Issue(*subject, role, *lifeguardID, *ttl)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(token)
+}
@@ -0,0 +1,13 @@
+module github.com/szbobrowski/master-thesis/auth
+
+go 1.22.5
+
+require google.golang.org/grpc v1.65.0
+
+require (
+	golang.org/x/net v0.26.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240604185151-ef581f913117 // indirect
+	google.golang.org/protobuf v1.34.1 // indirect
+)
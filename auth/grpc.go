@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const authorizationMetadata = "authorization"
+
+// Permissions lists the roles allowed to call each RPC, keyed by its full method name. Methods missing from
+// the map are denied to everyone, so a new RPC stays closed until its permissions are written down.
+type Permissions map[string][]Role
+
+// Reflection and health checks carry no data and are available without a token.
+var publicMethodPrefixes = []string{"/grpc.reflection.", "/grpc.health.v1.Health/"}
+
+func UnaryServerInterceptor(signer *Signer, permissions Permissions) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, signer, permissions, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(signer *Signer, permissions Permissions) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), signer, permissions, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func authorize(ctx context.Context, signer *Signer, permissions Permissions, fullMethod string) (context.Context, error) {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return ctx, nil
+		}
+	}
+
+	roles, ok := permissions[fullMethod]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "Metoda %s nie ma przypisanych uprawnień", fullMethod)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var token string
+	if values := md.Get(authorizationMetadata); len(values) > 0 {
+		token, _ = BearerToken(values[0])
+	}
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "Brak tokenu dostępu")
+	}
+
+	claims, err := signer.Verify(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if !claims.Role.In(roles) {
+		return nil, status.Errorf(codes.PermissionDenied, "Rola %s nie ma dostępu do metody %s", claims.Role, fullMethod)
+	}
+	if !claims.AllowsMethod(fullMethod) {
+		return nil, status.Errorf(codes.PermissionDenied, "Token nie obejmuje metody %s", fullMethod)
+	}
+
+	return NewContext(WithToken(ctx, token), claims), nil
+}
+
+// TokenSource supplies the token of calls made without a caller token in their context,
+// e.g. by background jobs of a service.
+type TokenSource func() (string, error)
+
+// UnaryClientInterceptor forwards the token of the caller stored with WithToken, falling back to the token source.
+func UnaryClientInterceptor(fallback TokenSource) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, err := withOutgoingToken(ctx, fallback)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func StreamClientInterceptor(fallback TokenSource) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, err := withOutgoingToken(ctx, fallback)
+		if err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func withOutgoingToken(ctx context.Context, fallback TokenSource) (context.Context, error) {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(authorizationMetadata)) > 0 {
+		return ctx, nil
+	}
+
+	token := TokenFromContext(ctx)
+	if token == "" && fallback != nil {
+		var err error
+		if token, err = fallback(); err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "Nie udało się uzyskać tokenu dostępu: %v", err)
+		}
+	}
+	if token == "" {
+		return ctx, nil
+	}
+	return metadata.AppendToOutgoingContext(ctx, authorizationMetadata, "Bearer "+token), nil
+}
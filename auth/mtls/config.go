@@ -0,0 +1,81 @@
+// Package mtls configures mutual TLS between client-handler and the gRPC services: certificates loaded
+// from files and reloaded when rotated, or a throwaway local CA for development.
+package mtls
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	ModeOff   = "off"
+	ModeFiles = "files"
+	ModeDev   = "dev"
+
+	defaultReloadInterval = 30 * time.Second
+)
+
+// Config describes the TLS setup of one service. Name is the identity of the service, put into the
+// certificates generated in dev mode. AllowedPeers lists the identities of clients a server accepts;
+// when empty, any client with a certificate signed by the CA is accepted.
+type Config struct {
+	Mode           string
+	Name           string
+	CertFile       string
+	KeyFile        string
+	CAFile         string
+	DevDir         string
+	AllowedPeers   []string
+	ReloadInterval time.Duration
+}
+
+// ConfigFromEnv reads TLS_MODE (off by default, files or dev), TLS_CERT_FILE, TLS_KEY_FILE and TLS_CA_FILE
+// for the files mode, TLS_DEV_DIR for the dev CA, TLS_ALLOWED_PEERS as a comma separated list replacing
+// defaultPeers and TLS_RELOAD_INTERVAL as a Go duration.
+func ConfigFromEnv(name string, defaultPeers ...string) (Config, error) {
+	cfg := Config{
+		Mode:           strings.ToLower(os.Getenv("TLS_MODE")),
+		Name:           name,
+		CertFile:       os.Getenv("TLS_CERT_FILE"),
+		KeyFile:        os.Getenv("TLS_KEY_FILE"),
+		CAFile:         os.Getenv("TLS_CA_FILE"),
+		DevDir:         os.Getenv("TLS_DEV_DIR"),
+		ReloadInterval: defaultReloadInterval,
+	}
+
+	for _, peer := range strings.Split(os.Getenv("TLS_ALLOWED_PEERS"), ",") {
+		if peer = strings.TrimSpace(peer); peer != "" {
+			cfg.AllowedPeers = append(cfg.AllowedPeers, peer)
+		}
+	}
+	if len(cfg.AllowedPeers) == 0 {
+		cfg.AllowedPeers = defaultPeers
+	}
+	if value := os.Getenv("TLS_RELOAD_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return cfg, fmt.Errorf("Niepoprawna wartość TLS_RELOAD_INTERVAL: %q", value)
+		}
+		cfg.ReloadInterval = interval
+	}
+
+	switch cfg.Mode {
+	case "", ModeOff:
+		cfg.Mode = ModeOff
+	case ModeFiles:
+		if cfg.CertFile == "" || cfg.KeyFile == "" || cfg.CAFile == "" {
+			return cfg, fmt.Errorf("Tryb %s wymaga zmiennych TLS_CERT_FILE, TLS_KEY_FILE i TLS_CA_FILE", ModeFiles)
+		}
+	case ModeDev:
+		if cfg.DevDir == "" {
+			cfg.DevDir = filepath.Join(os.TempDir(), "master-thesis-dev-ca")
+		}
+	default:
+		return cfg, fmt.Errorf("Nieznany tryb TLS: %q", cfg.Mode)
+	}
+
+	return cfg, nil
+}
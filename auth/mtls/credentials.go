@@ -0,0 +1,210 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Credentials hold the current certificate and CA pool of a service. A nil *Credentials stands for
+// TLS turned off, its options then give plaintext connections.
+type Credentials struct {
+	cfg Config
+
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+	roots       *x509.CertPool
+	modified    map[string]time.Time
+}
+
+// Load prepares the credentials of the configured mode. In files mode the files are checked every
+// ReloadInterval until ctx is done, so rotated certificates are used without a restart.
+func Load(ctx context.Context, cfg Config) (*Credentials, error) {
+	if cfg.Mode == ModeOff {
+		return nil, nil
+	}
+	c := &Credentials{cfg: cfg, modified: map[string]time.Time{}}
+
+	switch cfg.Mode {
+	case ModeDev:
+		ca, err := loadOrCreateDevCA(cfg.DevDir)
+		if err != nil {
+			return nil, err
+		}
+		certificate, err := ca.issue(cfg.Name)
+		if err != nil {
+			return nil, err
+		}
+		c.certificate = certificate
+		c.roots = x509.NewCertPool()
+		c.roots.AddCert(ca.certificate)
+		log.Printf("Tryb deweloperski TLS, certyfikat %s wystawiony przez lokalne CA z katalogu %s\n", cfg.Name, cfg.DevDir)
+		return c, nil
+
+	default:
+		if _, err := c.reload(); err != nil {
+			return nil, err
+		}
+		go c.watch(ctx)
+		return c, nil
+	}
+}
+
+func (c *Credentials) watch(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := c.reload()
+			if err != nil {
+				log.Printf("Nie udało się przeładować certyfikatów TLS, używane są poprzednie: %v\n", err)
+			} else if reloaded {
+				log.Println("Przeładowano certyfikaty TLS")
+			}
+		}
+	}
+}
+
+// reload reads the files again when any of them changed since the last successful load.
+func (c *Credentials) reload() (bool, error) {
+	files := []string{c.cfg.CertFile, c.cfg.KeyFile, c.cfg.CAFile}
+	modified := map[string]time.Time{}
+	changed := false
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, err
+		}
+		modified[file] = info.ModTime()
+		c.mu.RLock()
+		changed = changed || !info.ModTime().Equal(c.modified[file])
+		c.mu.RUnlock()
+	}
+	if !changed {
+		return false, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(c.cfg.CertFile, c.cfg.KeyFile)
+	if err != nil {
+		return false, fmt.Errorf("Błąd podczas wczytywania certyfikatu %s: %w", c.cfg.CertFile, err)
+	}
+	caPEM, err := os.ReadFile(c.cfg.CAFile)
+	if err != nil {
+		return false, err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caPEM) {
+		return false, fmt.Errorf("Plik %s nie zawiera certyfikatów CA", c.cfg.CAFile)
+	}
+
+	c.mu.Lock()
+	c.certificate, c.roots, c.modified = &certificate, roots, modified
+	c.mu.Unlock()
+	return true, nil
+}
+
+func (c *Credentials) current() (*tls.Certificate, *x509.CertPool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.certificate, c.roots
+}
+
+// ServerOption requires clients to present a certificate signed by the CA and, when AllowedPeers is set,
+// issued to one of the allowed identities.
+func (c *Credentials) ServerOption() grpc.ServerOption {
+	if c == nil {
+		return grpc.EmptyServerOption{}
+	}
+
+	return grpc.Creds(credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The chain is verified in VerifyConnection against the current CA pool, which may be reloaded.
+		ClientAuth: tls.RequireAnyClientCert,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			certificate, _ := c.current()
+			return certificate, nil
+		},
+		VerifyConnection: func(state tls.ConnectionState) error {
+			return c.verifyPeer(state.PeerCertificates, x509.ExtKeyUsageClientAuth, c.cfg.AllowedPeers)
+		},
+	}))
+}
+
+// DialOption connects to a server whose certificate has to be issued to the peer identity, e.g.
+// "emergency-services", independently of the address dialed.
+func (c *Credentials) DialOption(peer string) grpc.DialOption {
+	if c == nil {
+		return grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+
+	return grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: peer,
+		// The standard verification checks the dialed host name and a fixed CA pool, both are replaced
+		// by VerifyConnection, which checks the peer identity against the current pool.
+		InsecureSkipVerify: true,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certificate, _ := c.current()
+			return certificate, nil
+		},
+		VerifyConnection: func(state tls.ConnectionState) error {
+			return c.verifyPeer(state.PeerCertificates, x509.ExtKeyUsageServerAuth, []string{peer})
+		},
+	}))
+}
+
+func (c *Credentials) verifyPeer(certificates []*x509.Certificate, usage x509.ExtKeyUsage, allowed []string) error {
+	if len(certificates) == 0 {
+		return errors.New("Druga strona połączenia nie przedstawiła certyfikatu")
+	}
+
+	_, roots := c.current()
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	_, err := certificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	})
+	if err != nil {
+		return fmt.Errorf("Niepoprawny certyfikat drugiej strony połączenia: %w", err)
+	}
+
+	if len(allowed) > 0 && !hasIdentity(certificates[0], allowed) {
+		return fmt.Errorf("Certyfikat %q nie należy do żadnej z dozwolonych tożsamości: %v", certificates[0].Subject.CommonName, allowed)
+	}
+	return nil
+}
+
+// The identity of a certificate is any of its DNS or URI names or, for older certificates, its common name.
+func hasIdentity(certificate *x509.Certificate, allowed []string) bool {
+	identities := append([]string{certificate.Subject.CommonName}, certificate.DNSNames...)
+	for _, uri := range certificate.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	for _, identity := range identities {
+		for _, name := range allowed {
+			if identity != "" && identity == name {
+				return true
+			}
+		}
+	}
+	return false
+}
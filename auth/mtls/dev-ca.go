@@ -0,0 +1,223 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	devCAFile     = "ca.pem"
+	devCAValidity = 7 * 24 * time.Hour
+	// A CA closer to expiry than this is replaced, so that certificates issued at startup stay valid for a while.
+	devCAMinRemaining = 24 * time.Hour
+)
+
+// devCA is a throwaway certificate authority shared by the services on one machine. Its certificate and
+// key are kept together in DevDir, the service certificates are issued at startup and never written to disk.
+type devCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func loadOrCreateDevCA(dir string) (*devCA, error) {
+	path := filepath.Join(dir, devCAFile)
+	previous, err := os.ReadFile(path)
+	if err == nil {
+		ca, err := parseDevCA(path, previous)
+		if err == nil && time.Now().Add(devCAMinRemaining).Before(ca.certificate.NotAfter) {
+			return ca, nil
+		}
+	}
+
+	// Services started together race for the file: a new CA is linked, so the one written first wins,
+	// and everyone reads the CA back from the file instead of using their own. A CA being replaced cannot
+	// be linked over, so its successor is linked under a name derived from the old file and then copied over it.
+	target := path
+	if err == nil {
+		sum := sha256.Sum256(previous)
+		target = filepath.Join(dir, fmt.Sprintf("ca-next-%x.pem", sum[:8]))
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("Nie udało się odczytać lokalnego CA %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("Nie udało się utworzyć katalogu lokalnego CA %s: %w", dir, err)
+	}
+	data, err := newDevCA()
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := writeTemp(dir, data)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp)
+	if err := os.Link(tmp, target); err != nil && !errors.Is(err, fs.ErrExist) {
+		return nil, fmt.Errorf("Nie udało się zapisać lokalnego CA %s: %w", target, err)
+	}
+
+	ca, err := readDevCA(target)
+	if err != nil || target == path {
+		return ca, err
+	}
+	if err := replaceDevCA(dir, path, target); err != nil {
+		return nil, fmt.Errorf("Nie udało się zapisać lokalnego CA %s: %w", path, err)
+	}
+	return ca, nil
+}
+
+// replaceDevCA puts the successor in place of the CA file. Every service that lost the race renames the same
+// content, so the order does not matter. Successors of earlier CAs are no longer read by anyone and are removed,
+// the current one stays, it decides the race of services still holding the replaced file.
+func replaceDevCA(dir, path, successor string) error {
+	data, err := os.ReadFile(successor)
+	if err != nil {
+		return err
+	}
+	tmp, err := writeTemp(dir, data)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+
+	stale, _ := filepath.Glob(filepath.Join(dir, "ca-next-*.pem"))
+	for _, name := range stale {
+		if name != successor {
+			os.Remove(name)
+		}
+	}
+	return nil
+}
+
+func writeTemp(dir string, data []byte) (string, error) {
+	tmp, err := os.CreateTemp(dir, "ca-*.tmp")
+	if err != nil {
+		return "", err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
+
+func readDevCA(path string) (*devCA, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseDevCA(path, data)
+}
+
+func parseDevCA(path string, data []byte) (*devCA, error) {
+	var err error
+	ca := &devCA{}
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case "CERTIFICATE":
+			ca.certificate, err = x509.ParseCertificate(block.Bytes)
+		case "EC PRIVATE KEY":
+			ca.key, err = x509.ParseECPrivateKey(block.Bytes)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Niepoprawny plik lokalnego CA %s: %w", path, err)
+		}
+	}
+	if ca.certificate == nil || ca.key == nil {
+		return nil, fmt.Errorf("Plik lokalnego CA %s nie zawiera certyfikatu i klucza", path)
+	}
+	return ca, nil
+}
+
+func newDevCA() ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "master-thesis dev CA"},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(devCAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("Nie udało się utworzyć certyfikatu lokalnego CA: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return append(data, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})...), nil
+}
+
+// issue creates a certificate of a service, valid for both sides of a connection on localhost.
+func (ca *devCA) issue(name string) (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name, "localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     ca.certificate.NotAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, fmt.Errorf("Nie udało się wystawić certyfikatu %s: %w", name, err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, nil
+}
+
+func serialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
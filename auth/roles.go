@@ -0,0 +1,44 @@
+// Package auth implements the bearer tokens shared by client-handler and the gRPC services, the roles
+// carried by them and the gRPC interceptors that propagate and check the tokens.
+package auth
+
+import "fmt"
+
+type Role string
+
+const (
+	RoleDispatcher     Role = "dispatcher"
+	RoleLifeguard      Role = "lifeguard"
+	RoleSupervisor     Role = "supervisor"
+	RoleAdmin          Role = "admin"
+	RolePublicReporter Role = "public_reporter"
+
+	// RoleService is held by the services themselves for calls not made on behalf of any user.
+	RoleService Role = "service"
+)
+
+var Roles = []Role{RoleDispatcher, RoleLifeguard, RoleSupervisor, RoleAdmin, RolePublicReporter, RoleService}
+
+// UserRoles are the roles of people and of the API keys acting for them.
+var UserRoles = []Role{RoleDispatcher, RoleLifeguard, RoleSupervisor, RoleAdmin, RolePublicReporter}
+
+// StaffRoles are the roles of people working in the emergency service, everyone except public reporters.
+var StaffRoles = []Role{RoleDispatcher, RoleLifeguard, RoleSupervisor, RoleAdmin}
+
+func ParseRole(name string) (Role, error) {
+	for _, role := range Roles {
+		if string(role) == name {
+			return role, nil
+		}
+	}
+	return "", fmt.Errorf("Nieznana rola: %q", name)
+}
+
+func (r Role) In(roles []Role) bool {
+	for _, role := range roles {
+		if role == r {
+			return true
+		}
+	}
+	return false
+}
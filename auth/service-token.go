@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	serviceTokenTTL     = 15 * time.Minute
+	serviceTokenRenewal = time.Minute
+)
+
+// ServiceTokenSource issues short-lived tokens for the own calls of a service and reuses each token
+// until it is close to expiring.
+func ServiceTokenSource(signer *Signer, subject string, role Role) TokenSource {
+	var (
+		mu      sync.Mutex
+		token   string
+		expires time.Time
+	)
+
+	return func() (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		now := signer.now()
+		if token != "" && now.Add(serviceTokenRenewal).Before(expires) {
+			return token, nil
+		}
+
+		issued, err := signer.Issue(subject, role, 0, serviceTokenTTL)
+		if err != nil {
+			return "", err
+		}
+		token, expires = issued, now.Add(serviceTokenTTL)
+		return token, nil
+	}
+}
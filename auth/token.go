@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	SecretEnv       = "AUTH_TOKEN_SECRET"
+	minSecretLength = 32
+)
+
+var (
+	ErrInvalidToken = errors.New("Niepoprawny token dostępu")
+	ErrExpiredToken = errors.New("Token dostępu wygasł")
+)
+
+// Claims are the payload of a token. LifeguardID links a token of the lifeguard role to the lifeguard
+// record its holder may update. Methods, when not empty, narrows the token down to the listed RPCs.
+type Claims struct {
+	Subject     string   `json:"sub"`
+	Role        Role     `json:"role"`
+	LifeguardID int64    `json:"lifeguard_id,omitempty"`
+	Methods     []string `json:"methods,omitempty"`
+	IssuedAt    int64    `json:"iat"`
+	ExpiresAt   int64    `json:"exp"`
+}
+
+// AllowsMethod matches a full method name against Methods, where "/main.IncidentService/*" stands for
+// every method of the service.
+func (c Claims) AllowsMethod(fullMethod string) bool {
+	if len(c.Methods) == 0 {
+		return true
+	}
+	for _, method := range c.Methods {
+		if method == fullMethod {
+			return true
+		}
+		if service, ok := strings.CutSuffix(method, "*"); ok && strings.HasSuffix(service, "/") && strings.HasPrefix(fullMethod, service) {
+			return true
+		}
+	}
+	return false
+}
+
+// Signer issues and verifies HS256 JSON Web Tokens. All services share the secret, so a token issued
+// for a user of client-handler is accepted by the gRPC servers it calls on their behalf.
+type Signer struct {
+	secret []byte
+	now    func() time.Time
+}
+
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+func NewSigner(secret []byte) (*Signer, error) {
+	if len(secret) < minSecretLength {
+		return nil, fmt.Errorf("Sekret tokenów musi mieć co najmniej %d bajtów", minSecretLength)
+	}
+	return &Signer{secret: secret, now: time.Now}, nil
+}
+
+func SignerFromEnv() (*Signer, error) {
+	secret := os.Getenv(SecretEnv)
+	if secret == "" {
+		return nil, fmt.Errorf("Brak zmiennej %s", SecretEnv)
+	}
+	return NewSigner([]byte(secret))
+}
+
+func (s *Signer) Issue(subject string, role Role, lifeguardID int64, ttl time.Duration) (string, error) {
+	now := s.now()
+	return s.Sign(Claims{
+		Subject:     subject,
+		Role:        role,
+		LifeguardID: lifeguardID,
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   now.Add(ttl).Unix(),
+	})
+}
+
+func (s *Signer) Sign(claims Claims) (string, error) {
+	if _, err := ParseRole(string(claims.Role)); err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(s.signature(unsigned)), nil
+}
+
+// Verify accepts only HS256 tokens with a known role and an expiry time that has not passed.
+func (s *Signer) Verify(token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, s.signature(parts[0]+"."+parts[1])) {
+		return Claims{}, ErrInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return Claims{}, ErrInvalidToken
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+	if _, err := ParseRole(string(claims.Role)); err != nil || claims.Subject == "" || claims.ExpiresAt == 0 {
+		return Claims{}, ErrInvalidToken
+	}
+	if s.now().Unix() >= claims.ExpiresAt {
+		return Claims{}, ErrExpiredToken
+	}
+
+	return claims, nil
+}
+
+func (s *Signer) signature(unsigned string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(unsigned))
+	return mac.Sum(nil)
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// BearerToken returns the token of an "Authorization: Bearer <token>" header value.
+func BearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+type contextKey int
+
+const (
+	claimsContextKey contextKey = iota
+	tokenContextKey
+)
+
+func NewContext(ctx context.Context, claims Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey, claims)
+}
+
+func FromContext(ctx context.Context) (Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(Claims)
+	return claims, ok
+}
+
+// WithToken stores the raw token of the caller, the client interceptors send it with every outgoing call.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenContextKey, token)
+}
+
+func TokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(tokenContextKey).(string)
+	return token
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/szbobrowski/master-thesis/auth"
+)
+
+const (
+	apiKeyHeader     = "X-API-Key"
+	apiKeyCacheTTL   = 30 * time.Second
+	apiKeyTokenTTL   = 5 * time.Minute
+	maxCachedApiKeys = 10000
+)
+
+var apiKeyClient ApiKeyServiceClient
+
+// ApiKeyAuthenticator exchanges an API key for a short-lived internal token carrying the role and methods
+// of the key, which the backend services check like any other token. Checked keys are remembered for
+// apiKeyCacheTTL, so a revoked key may keep working for that long.
+type ApiKeyAuthenticator struct {
+	client       ApiKeyServiceClient
+	signer       *auth.Signer
+	serviceToken auth.TokenSource
+
+	mu    sync.Mutex
+	cache map[string]apiKeyGrant
+}
+
+type apiKeyGrant struct {
+	token     string
+	claims    auth.Claims
+	checkedAt time.Time
+}
+
+func NewApiKeyAuthenticator(client ApiKeyServiceClient, signer *auth.Signer, serviceToken auth.TokenSource) *ApiKeyAuthenticator {
+	return &ApiKeyAuthenticator{
+		client:       client,
+		signer:       signer,
+		serviceToken: serviceToken,
+		cache:        map[string]apiKeyGrant{},
+	}
+}
+
+// Authenticate returns the internal token of a key, errors are gRPC statuses of the key check.
+func (a *ApiKeyAuthenticator) Authenticate(ctx context.Context, key string) (string, auth.Claims, error) {
+	sum := sha256.Sum256([]byte(key))
+	cacheKey := hex.EncodeToString(sum[:])
+	now := time.Now()
+
+	a.mu.Lock()
+	grant, ok := a.cache[cacheKey]
+	a.mu.Unlock()
+	if ok && now.Sub(grant.checkedAt) < apiKeyCacheTTL && now.Unix() < grant.claims.ExpiresAt {
+		return grant.token, grant.claims, nil
+	}
+
+	serviceToken, err := a.serviceToken()
+	if err != nil {
+		return "", auth.Claims{}, err
+	}
+	response, err := a.client.AuthenticateApiKey(auth.WithToken(ctx, serviceToken), &AuthenticateApiKeyRequest{Secret: key})
+	if err != nil {
+		return "", auth.Claims{}, err
+	}
+
+	apiKey := response.ApiKey
+	role, err := auth.ParseRole(apiKey.Role)
+	if err != nil {
+		return "", auth.Claims{}, err
+	}
+	claims := auth.Claims{
+		Subject:   fmt.Sprintf("api-key:%d", apiKey.Id),
+		Role:      role,
+		Methods:   apiKey.Methods,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(apiKeyTokenTTL).Unix(),
+	}
+	if expiresAt, err := time.Parse(time.RFC3339, apiKey.ExpiresAt); err == nil && expiresAt.Unix() < claims.ExpiresAt {
+		claims.ExpiresAt = expiresAt.Unix()
+	}
+
+	token, err := a.signer.Sign(claims)
+	if err != nil {
+		return "", auth.Claims{}, err
+	}
+
+	a.mu.Lock()
+	if len(a.cache) >= maxCachedApiKeys {
+		a.cache = map[string]apiKeyGrant{}
+	}
+	a.cache[cacheKey] = apiKeyGrant{token: token, claims: claims, checkedAt: now}
+	a.mu.Unlock()
+
+	return token, claims, nil
+}
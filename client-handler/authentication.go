@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"github.com/szbobrowski/master-thesis/auth"
+	"github.com/szbobrowski/master-thesis/auth/mtls"
+	"google.golang.org/grpc"
+)
+
+const serviceTokenSubject = "client-handler"
+
+var tokenSigner *auth.Signer
+
+// grpcCredentials are nil when TLS is turned off, the connections to the backends are then plaintext.
+var grpcCredentials *mtls.Credentials
+
+// The API description and the health checks are public, everything else requires a token.
+var publicPaths = map[string]bool{
+	"/openapi.json":              true,
+	"/docs":                      true,
+	"/docs/swagger-ui.css":       true,
+	"/docs/swagger-ui-bundle.js": true,
+	"/healthz":                   true,
+	"/readyz":                    true,
+}
+
+// withAuthentication verifies the bearer token of every request and stores its claims and the token itself
+// in the request context, the gRPC client interceptors forward the token to the backend services.
+// Machine clients may send an API key in X-API-Key instead, it is exchanged for an internal token.
+// Browsers cannot set headers on EventSource and WebSocket requests, so the SSE stream also accepts the token
+// in the access_token query parameter and a GraphQL WebSocket connection may authenticate in connection_init.
+func withAuthentication(signer *auth.Signer, apiKeys *ApiKeyAuthenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if publicPaths[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if key := r.Header.Get(apiKeyHeader); key != "" {
+			token, claims, err := apiKeys.Authenticate(r.Context(), key)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="client-handler"`)
+				writeGrpcError(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(auth.NewContext(auth.WithToken(r.Context(), token), claims)))
+			return
+		}
+
+		token, ok := auth.BearerToken(r.Header.Get("Authorization"))
+		if !ok && r.URL.Path == "/incidents/stream" {
+			token = r.URL.Query().Get("access_token")
+		}
+		if token == "" {
+			if r.URL.Path == "/graphql" && websocket.IsWebSocketUpgrade(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			w.Header().Set("WWW-Authenticate", `Bearer realm="client-handler"`)
+			writeJSONError(w, r, http.StatusUnauthorized, "Brak tokenu dostępu")
+			return
+		}
+
+		claims, err := signer.Verify(token)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="client-handler", error="invalid_token"`)
+			writeJSONError(w, r, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(auth.NewContext(auth.WithToken(r.Context(), token), claims)))
+	})
+}
+
+// requirePermission answers 403 unless the caller could call the RPC behind the data. Calls to the backend
+// services are checked there, this is needed only for data client-handler serves itself.
+func requirePermission(w http.ResponseWriter, r *http.Request, fullMethod string, roles []auth.Role) bool {
+	claims, _ := auth.FromContext(r.Context())
+	if !claims.Role.In(roles) {
+		writeJSONError(w, r, http.StatusForbidden, "Rola "+string(claims.Role)+" nie ma dostępu do tego zasobu")
+		return false
+	}
+	if !claims.AllowsMethod(fullMethod) {
+		writeJSONError(w, r, http.StatusForbidden, "Token nie obejmuje metody "+fullMethod)
+		return false
+	}
+	return true
+}
+
+// A token sent in the connection_init payload, as {"Authorization": "Bearer <token>"} or {"authToken": "<token>"}.
+func tokenFromConnectionInit(payload json.RawMessage) string {
+	var params map[string]interface{}
+	if len(payload) == 0 || json.Unmarshal(payload, &params) != nil {
+		return ""
+	}
+	for _, key := range []string{"Authorization", "authorization"} {
+		if header, ok := params[key].(string); ok {
+			token, _ := auth.BearerToken(header)
+			return token
+		}
+	}
+	token, _ := params["authToken"].(string)
+	return token
+}
+
+func authenticatedContext(ctx context.Context, token string) (context.Context, error) {
+	claims, err := tokenSigner.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	return auth.NewContext(auth.WithToken(ctx, token), claims), nil
+}
+
+// Calls made on behalf of a user carry the token of the user, see withAuthentication.
+func grpcAuthOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(auth.UnaryClientInterceptor(nil)),
+		grpc.WithChainStreamInterceptor(auth.StreamClientInterceptor(nil)),
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker stops calling a backend after threshold consecutive failures. While open, calls fail at once;
+// after cooldown a single call is let through and its result decides whether the circuit closes again.
+type circuitBreaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newCircuitBreaker(name string, threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{name: name, threshold: threshold, cooldown: cooldown}
+}
+
+// allow reports whether a call may be made now.
+func (b *circuitBreaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = circuitHalfOpen
+		b.probing = true
+		return true
+	case circuitHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// record updates the circuit with the result of a call let through by allow.
+func (b *circuitBreaker) record(ctx context.Context, err error) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// A call the client gave up on says nothing about the backend.
+	if errors.Is(ctx.Err(), context.Canceled) {
+		b.probing = false
+		return
+	}
+	if !isBackendFailure(err) {
+		if b.state != circuitClosed {
+			log.Printf("Usługa %s znów odpowiada, obwód zamknięty\n", b.name)
+		}
+		b.state, b.failures, b.probing = circuitClosed, 0, false
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= b.threshold {
+		if b.state != circuitOpen {
+			log.Printf("Obwód do usługi %s otwarty po %d kolejnych błędach, zapytania będą odrzucane przez %v, error: %v\n", b.name, b.failures, b.cooldown, err)
+		}
+		b.state, b.openedAt, b.probing = circuitOpen, time.Now(), false
+	}
+}
+
+func (b *circuitBreaker) current() circuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+func (b *circuitBreaker) rejection() error {
+	return status.Errorf(codes.Unavailable, "Usługa %s jest chwilowo niedostępna", b.name)
+}
+
+// Only errors saying the backend is down or overloaded count, rejected requests do not.
+func isBackendFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
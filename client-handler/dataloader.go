@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// Loader collects the keys requested by GraphQL resolvers and fetches them together in batches.
+// Results are cached for the lifetime of the loader, which is one GraphQL operation.
+type Loader[K comparable, V any] struct {
+	fetch    func(ctx context.Context, keys []K) (map[K]V, error)
+	missing  func(key K) error
+	maxBatch int
+
+	mu      sync.Mutex
+	cache   map[K]*loaderEntry[V]
+	pending []K
+}
+
+type loaderEntry[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// NewLoader creates a loader. Keys left out by fetch resolve to the error returned by missing,
+// or to the zero value when missing is nil.
+func NewLoader[K comparable, V any](maxBatch int, fetch func(context.Context, []K) (map[K]V, error), missing func(K) error) *Loader[K, V] {
+	return &Loader[K, V]{
+		fetch:    fetch,
+		missing:  missing,
+		maxBatch: maxBatch,
+		cache:    map[K]*loaderEntry[V]{},
+	}
+}
+
+// Load schedules the key and returns a thunk. graphql-go calls thunks only after all fields on the same
+// level are resolved, so the first thunk called fetches the keys of the whole level at once.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) func() (V, error) {
+	entry := l.schedule(key)
+	return func() (V, error) {
+		l.dispatch(ctx)
+		<-entry.done
+		return entry.value, entry.err
+	}
+}
+
+func (l *Loader[K, V]) LoadMany(ctx context.Context, keys []K) func() ([]V, error) {
+	entries := make([]*loaderEntry[V], 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, l.schedule(key))
+	}
+
+	return func() ([]V, error) {
+		l.dispatch(ctx)
+		values := make([]V, 0, len(entries))
+		for _, entry := range entries {
+			<-entry.done
+			if entry.err != nil {
+				return nil, entry.err
+			}
+			values = append(values, entry.value)
+		}
+		return values, nil
+	}
+}
+
+func (l *Loader[K, V]) schedule(key K) *loaderEntry[V] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.cache[key]
+	if !ok {
+		entry = &loaderEntry[V]{done: make(chan struct{})}
+		l.cache[key] = entry
+		l.pending = append(l.pending, key)
+	}
+	return entry
+}
+
+func (l *Loader[K, V]) dispatch(ctx context.Context) {
+	l.mu.Lock()
+	keys := l.pending
+	l.pending = nil
+	l.mu.Unlock()
+
+	for start := 0; start < len(keys); start += l.maxBatch {
+		end := start + l.maxBatch
+		if end > len(keys) {
+			end = len(keys)
+		}
+		batch := keys[start:end]
+
+		values, err := l.fetch(ctx, batch)
+
+		l.mu.Lock()
+		for _, key := range batch {
+			entry := l.cache[key]
+			value, found := values[key]
+			switch {
+			case err != nil:
+				entry.err = err
+			case found:
+				entry.value = value
+			case l.missing != nil:
+				entry.err = l.missing(key)
+			}
+			close(entry.done)
+		}
+		l.mu.Unlock()
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import "strings"
+
+const (
+	specializationPrefix = "SPECIALIZATION_"
+	vehicleTypePrefix    = "VEHICLE_TYPE_"
+
+	incidentStatusPrefix     = "INCIDENT_STATUS_"
+	incidentSeverityPrefix   = "INCIDENT_SEVERITY_"
+	incidentChangeTypePrefix = "INCIDENT_CHANGE_TYPE_"
+)
+
+// Values which are not exact enum names are passed on as legacy text and normalized by emergency-services.
+func enumFromName(value, prefix string, values map[string]int32) (int32, bool) {
+	name := strings.ToUpper(strings.TrimSpace(value))
+	for _, candidate := range []string{name, prefix + name} {
+		if number, ok := values[candidate]; ok && number != 0 {
+			return number, true
+		}
+	}
+	return 0, false
+}
+
+func specializationFromString(value string) (Specialization, string) {
+	if number, ok := enumFromName(value, specializationPrefix, Specialization_value); ok {
+		return Specialization(number), ""
+	}
+	return Specialization_SPECIALIZATION_UNSPECIFIED, value
+}
+
+func vehicleTypeFromString(value string) (VehicleType, string) {
+	if number, ok := enumFromName(value, vehicleTypePrefix, VehicleType_value); ok {
+		return VehicleType(number), ""
+	}
+	return VehicleType_VEHICLE_TYPE_UNSPECIFIED, value
+}
+
+func specializationToString(specialization Specialization, legacy string) string {
+	if specialization == Specialization_SPECIALIZATION_UNSPECIFIED {
+		return legacy
+	}
+	return strings.TrimPrefix(specialization.String(), specializationPrefix)
+}
+
+func vehicleTypeToString(vehicleType VehicleType, legacy string) string {
+	if vehicleType == VehicleType_VEHICLE_TYPE_UNSPECIFIED {
+		return legacy
+	}
+	return strings.TrimPrefix(vehicleType.String(), vehicleTypePrefix)
+}
@@ -2,14 +2,25 @@ module github.com/szbobrowski/master-thesis/client-handler
 
 go 1.22.6
 
+require (
+	github.com/gorilla/websocket v1.5.3
+	github.com/graphql-go/graphql v0.8.1
+	github.com/szbobrowski/master-thesis/auth v0.0.0
+	github.com/szbobrowski/master-thesis/validation v0.0.0
+	google.golang.org/genproto/googleapis/api v0.0.0-20240604185151-ef581f913117
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240604185151-ef581f913117
+	google.golang.org/grpc v1.66.0
+	google.golang.org/protobuf v1.34.2
+)
+
 require (
 	github.com/go-chi/chi/v5 v5.1.0 // indirect
 	github.com/gorilla/mux v1.8.1 // indirect
-	github.com/graphql-go/graphql v0.8.1 // indirect
 	golang.org/x/net v0.26.0 // indirect
 	golang.org/x/sys v0.21.0 // indirect
 	golang.org/x/text v0.16.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240604185151-ef581f913117 // indirect
-	google.golang.org/grpc v1.66.0 // indirect
-	google.golang.org/protobuf v1.34.2 // indirect
 )
+
+replace github.com/szbobrowski/master-thesis/auth => ../auth
+
+replace github.com/szbobrowski/master-thesis/validation => ../validation
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+const (
+	maxGraphQLBodySize = 1 << 20
+	maxGraphQLBatch    = 20
+
+	graphqlResponseMediaType = "application/graphql-response+json"
+	jsonMediaType            = "application/json"
+)
+
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+	Extensions    map[string]interface{} `json:"extensions"`
+}
+
+// graphqlHandler serves GraphQL over HTTP: queries by GET, any operation by POST, and batches as a JSON array.
+// Subscriptions are served only over WebSocket.
+func graphqlHandler(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		graphqlWebSocketHandler(w, r)
+		return
+	}
+
+	mediaType := responseMediaType(r.Header.Get("Accept"))
+	if mediaType == "" {
+		http.Error(w, "Nieobsługiwany format odpowiedzi, dostępne: application/json, application/graphql-response+json", http.StatusNotAcceptable)
+		return
+	}
+
+	var requests []graphqlRequest
+	batched := false
+	switch r.Method {
+	case http.MethodGet:
+		request, err := graphqlRequestFromQuery(r)
+		if err != nil {
+			writeGraphQLError(w, mediaType, http.StatusBadRequest, err.Error())
+			return
+		}
+		requests = []graphqlRequest{request}
+
+	case http.MethodPost:
+		var status int
+		var err error
+		requests, batched, status, err = graphqlRequestsFromBody(w, r)
+		if err != nil {
+			log.Printf("Błąd podczas dekodowania treści zapytania: %v\n", err)
+			writeGraphQLError(w, mediaType, status, err.Error())
+			return
+		}
+
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeGraphQLError(w, mediaType, http.StatusMethodNotAllowed, "Metoda niedozwolona, dostępne: GET, POST")
+		return
+	}
+
+	// A single Idempotency-Key cannot identify several operations, so the header applies only to non-batched requests.
+	ctx := r.Context()
+	if !batched {
+		ctx = context.WithValue(ctx, idempotencyKeyContextKey, r.Header.Get("Idempotency-Key"))
+	}
+
+	// All operations are prepared before any is executed, so that a batch is held to the complexity limit as a whole.
+	prepareErrors := make([]error, len(requests))
+	totalComplexity := 0
+	for i := range requests {
+		complexity, err := prepareGraphQLRequest(&requests[i])
+		prepareErrors[i] = err
+		totalComplexity += complexity
+	}
+	if batched {
+		if err := checkBatchComplexity(totalComplexity, queryLimits); err != nil {
+			w.Header().Set("Content-Type", mediaType)
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(&graphql.Result{Errors: []gqlerrors.FormattedError{formatGraphQLError(err)}})
+			return
+		}
+	}
+
+	results := make([]*graphql.Result, 0, len(requests))
+	status := http.StatusOK
+	for i, request := range requests {
+		result, requestStatus := executeGraphQLRequest(ctx, r.Method, request, prepareErrors[i])
+		results = append(results, result)
+		status = requestStatus
+	}
+
+	w.Header().Set("Content-Type", mediaType)
+	if batched {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(results)
+		return
+	}
+
+	if status == http.StatusMethodNotAllowed {
+		w.Header().Set("Allow", http.MethodPost)
+	}
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(results[0])
+}
+
+// executeGraphQLRequest runs a request already passed through prepareGraphQLRequest, or reports the error it returned.
+func executeGraphQLRequest(ctx context.Context, method string, request graphqlRequest, prepareErr error) (*graphql.Result, int) {
+	if err := prepareErr; err != nil {
+		status := http.StatusBadRequest
+		var requestErr *graphqlRequestError
+		if errors.As(err, &requestErr) {
+			status = requestErr.status
+		}
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{formatGraphQLError(err)}}, status
+	}
+
+	switch operationType(request) {
+	case ast.OperationTypeSubscription:
+		return graphqlErrorResult("Subskrypcje są dostępne wyłącznie przez WebSocket"), http.StatusBadRequest
+	case ast.OperationTypeMutation:
+		if method == http.MethodGet {
+			return graphqlErrorResult("Mutacje wymagają metody POST"), http.StatusMethodNotAllowed
+		}
+	}
+
+	log.Printf("Otrzymano kwerendę GraphQL: %s\n", request.Query)
+
+	result := graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        withLoaders(ctx),
+	})
+
+	// Without data the request never reached execution: it failed to parse, validate or coerce variables.
+	if result.Data == nil && result.HasErrors() {
+		return result, http.StatusBadRequest
+	}
+	return result, http.StatusOK
+}
+
+// prepareGraphQLRequest resolves persisted queries and rejects operations exceeding the query limits
+// before anything is executed. It returns the cost of the operation.
+func prepareGraphQLRequest(request *graphqlRequest) (int, error) {
+	if err := persistedQueries.Resolve(request); err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(request.Query) == "" {
+		return 0, &graphqlRequestError{
+			status:  http.StatusBadRequest,
+			code:    "QUERY_MISSING",
+			message: "Brak kwerendy w polu query",
+		}
+	}
+	return checkQueryLimits(*request, queryLimits)
+}
+
+func graphqlRequestFromQuery(r *http.Request) (graphqlRequest, error) {
+	query := r.URL.Query()
+	request := graphqlRequest{
+		Query:         query.Get("query"),
+		OperationName: query.Get("operationName"),
+	}
+
+	if variables := query.Get("variables"); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+			return request, fmt.Errorf("Niepoprawny format parametru variables: %v", err)
+		}
+	}
+	if extensions := query.Get("extensions"); extensions != "" {
+		if err := json.Unmarshal([]byte(extensions), &request.Extensions); err != nil {
+			return request, fmt.Errorf("Niepoprawny format parametru extensions: %v", err)
+		}
+	}
+	return request, nil
+}
+
+func graphqlRequestsFromBody(w http.ResponseWriter, r *http.Request) ([]graphqlRequest, bool, int, error) {
+	contentType := r.Header.Get("Content-Type")
+	mediaType := jsonMediaType
+	if contentType != "" {
+		var err error
+		mediaType, _, err = mime.ParseMediaType(contentType)
+		if err != nil {
+			return nil, false, http.StatusUnsupportedMediaType, fmt.Errorf("Niepoprawny nagłówek Content-Type: %v", err)
+		}
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGraphQLBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, false, http.StatusRequestEntityTooLarge, fmt.Errorf("Treść zapytania przekracza %d bajtów", maxGraphQLBodySize)
+		}
+		return nil, false, http.StatusBadRequest, fmt.Errorf("Błąd podczas odczytu treści zapytania: %v", err)
+	}
+
+	switch mediaType {
+	case "application/graphql":
+		return []graphqlRequest{{Query: string(body)}}, false, http.StatusOK, nil
+
+	case jsonMediaType:
+		body = bytes.TrimSpace(body)
+		if len(body) > 0 && body[0] == '[' {
+			var requests []graphqlRequest
+			if err := json.Unmarshal(body, &requests); err != nil {
+				return nil, false, http.StatusBadRequest, fmt.Errorf("Błąd podczas dekodowania treści zapytania: %v", err)
+			}
+			if len(requests) == 0 || len(requests) > maxGraphQLBatch {
+				return nil, false, http.StatusBadRequest, fmt.Errorf("Paczka musi zawierać od 1 do %d operacji", maxGraphQLBatch)
+			}
+			return requests, true, http.StatusOK, nil
+		}
+
+		var request graphqlRequest
+		if err := json.Unmarshal(body, &request); err != nil {
+			return nil, false, http.StatusBadRequest, fmt.Errorf("Błąd podczas dekodowania treści zapytania: %v", err)
+		}
+		return []graphqlRequest{request}, false, http.StatusOK, nil
+
+	default:
+		return nil, false, http.StatusUnsupportedMediaType, fmt.Errorf("Nieobsługiwany typ treści %s, dostępne: application/json, application/graphql", mediaType)
+	}
+}
+
+// Clients asking for application/graphql-response+json get it, everyone else gets plain application/json.
+func responseMediaType(accept string) string {
+	if strings.TrimSpace(accept) == "" {
+		return jsonMediaType
+	}
+
+	acceptsJSON := false
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case graphqlResponseMediaType:
+			return graphqlResponseMediaType
+		case jsonMediaType, "application/*", "*/*":
+			acceptsJSON = true
+		}
+	}
+
+	if acceptsJSON {
+		return jsonMediaType
+	}
+	return ""
+}
+
+func operationType(request graphqlRequest) string {
+	document, err := parser.Parse(parser.ParseParams{Source: request.Query})
+	if err != nil {
+		return ""
+	}
+
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if request.OperationName != "" && (operation.Name == nil || operation.Name.Value != request.OperationName) {
+			continue
+		}
+		return operation.Operation
+	}
+	return ""
+}
+
+func graphqlErrorResult(message string) *graphql.Result {
+	return &graphql.Result{
+		Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(message)},
+	}
+}
+
+// gqlerrors.FormatError keeps extensions only of errors raised in resolvers, errors created outside of execution
+// carry their codes here.
+func formatGraphQLError(err error) gqlerrors.FormattedError {
+	formatted := gqlerrors.FormatError(err)
+	if extended, ok := err.(gqlerrors.ExtendedError); ok && formatted.Extensions == nil {
+		formatted.Extensions = extended.Extensions()
+	}
+	return formatted
+}
+
+func writeGraphQLError(w http.ResponseWriter, mediaType string, status int, message string) {
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(graphqlErrorResult(message))
+}
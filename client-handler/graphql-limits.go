@@ -0,0 +1,285 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+const (
+	defaultMaxQueryDepth      = 10
+	defaultMaxQueryComplexity = 1000
+
+	// Assumed size of lists whose length the query does not bound with a first argument.
+	defaultListSize = 10
+	// Page size of ListIncidents when first is not given.
+	defaultPageSize = 20
+)
+
+// Fields served by a backend call cost more than fields read from an already fetched object.
+var fieldCosts = map[string]int{
+	"Query.incident":            5,
+	"Query.incidents":           5,
+	"Query.lifeguard":           5,
+	"Query.lifeguards":          5,
+	"Query.vehicle":             5,
+	"Query.vehicles":            5,
+	"Incident.timeline":         5,
+	"Incident.assignedVehicles": 5,
+	"Lifeguard.vehicles":        5,
+	"Vehicle.lifeguardInCharge": 5,
+	"Mutation.createIncident":   10,
+	"Mutation.updateIncident":   10,
+	"Mutation.deleteIncident":   10,
+	"Mutation.addIncidentNote":  10,
+	"Mutation.createLifeguard":  10,
+	"Mutation.updateLifeguard":  10,
+	"Mutation.deleteLifeguard":  10,
+	"Mutation.createVehicle":    10,
+	"Mutation.updateVehicle":    10,
+	"Mutation.deleteVehicle":    10,
+}
+
+type QueryLimits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+var queryLimits = QueryLimits{
+	MaxDepth:      defaultMaxQueryDepth,
+	MaxComplexity: defaultMaxQueryComplexity,
+}
+
+// The limits are set with the GRAPHQL_MAX_DEPTH and GRAPHQL_MAX_COMPLEXITY environment variables, 0 disables a limit.
+func queryLimitsFromEnv() (QueryLimits, error) {
+	limits := QueryLimits{
+		MaxDepth:      defaultMaxQueryDepth,
+		MaxComplexity: defaultMaxQueryComplexity,
+	}
+
+	for name, limit := range map[string]*int{
+		"GRAPHQL_MAX_DEPTH":      &limits.MaxDepth,
+		"GRAPHQL_MAX_COMPLEXITY": &limits.MaxComplexity,
+	} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 0 {
+			return limits, fmt.Errorf("Niepoprawna wartość zmiennej %s: %q", name, value)
+		}
+		*limit = number
+	}
+	return limits, nil
+}
+
+// graphqlRequestError rejects a whole operation before it is executed.
+type graphqlRequestError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *graphqlRequestError) Error() string {
+	return e.message
+}
+
+func (e *graphqlRequestError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": e.code,
+	}
+}
+
+// checkQueryLimits measures the selected operation. Documents that cannot be parsed are left to graphql.Do,
+// which reports the syntax errors.
+func checkQueryLimits(request graphqlRequest, limits QueryLimits) (int, error) {
+	document, err := parser.Parse(parser.ParseParams{Source: request.Query})
+	if err != nil {
+		return 0, nil
+	}
+
+	analyzer := &queryAnalyzer{
+		fragments: map[string]*ast.FragmentDefinition{},
+		variables: request.Variables,
+	}
+	var operation *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			analyzer.fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if request.OperationName == "" || (definition.Name != nil && definition.Name.Value == request.OperationName) {
+				if operation == nil {
+					operation = definition
+				}
+			}
+		}
+	}
+	if operation == nil {
+		return 0, nil
+	}
+
+	var root *graphql.Object
+	switch operation.Operation {
+	case ast.OperationTypeQuery:
+		root = schema.QueryType()
+	case ast.OperationTypeMutation:
+		root = schema.MutationType()
+	case ast.OperationTypeSubscription:
+		root = schema.SubscriptionType()
+	}
+	if root == nil {
+		return 0, nil
+	}
+
+	depth, complexity := analyzer.selectionSet(operation.SelectionSet, root, 1, map[string]bool{})
+	if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+		return 0, &graphqlRequestError{
+			status:  http.StatusBadRequest,
+			code:    "QUERY_TOO_DEEP",
+			message: fmt.Sprintf("Zapytanie ma głębokość %d, dopuszczalna to %d", depth, limits.MaxDepth),
+		}
+	}
+	if limits.MaxComplexity > 0 && complexity > limits.MaxComplexity {
+		return 0, &graphqlRequestError{
+			status:  http.StatusBadRequest,
+			code:    "QUERY_TOO_COMPLEX",
+			message: fmt.Sprintf("Zapytanie ma koszt %d, dopuszczalny to %d", complexity, limits.MaxComplexity),
+		}
+	}
+	return complexity, nil
+}
+
+// checkBatchComplexity holds the operations of a batch together to the complexity limit of a single operation.
+func checkBatchComplexity(complexity int, limits QueryLimits) error {
+	if limits.MaxComplexity > 0 && complexity > limits.MaxComplexity {
+		return &graphqlRequestError{
+			status:  http.StatusBadRequest,
+			code:    "QUERY_TOO_COMPLEX",
+			message: fmt.Sprintf("Operacje w paczce mają łączny koszt %d, dopuszczalny to %d", complexity, limits.MaxComplexity),
+		}
+	}
+	return nil
+}
+
+type queryAnalyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+// selectionSet returns the deepest level reached below the set and its total cost. Introspection fields are not counted.
+func (a *queryAnalyzer) selectionSet(set *ast.SelectionSet, parent graphql.Type, depth int, visiting map[string]bool) (int, int) {
+	if set == nil {
+		return depth - 1, 0
+	}
+
+	maxDepth, complexity := depth, 0
+	for _, selection := range set.Selections {
+		var selectionDepth, cost int
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			selectionDepth, cost = a.field(selection, parent, depth, visiting)
+
+		case *ast.InlineFragment:
+			fragmentType := parent
+			if selection.TypeCondition != nil {
+				fragmentType = schema.Type(selection.TypeCondition.Name.Value)
+			}
+			selectionDepth, cost = a.selectionSet(selection.SelectionSet, fragmentType, depth, visiting)
+
+		case *ast.FragmentSpread:
+			fragment, ok := a.fragments[selection.Name.Value]
+			if !ok || visiting[fragment.Name.Value] {
+				continue
+			}
+			visiting[fragment.Name.Value] = true
+			selectionDepth, cost = a.selectionSet(fragment.SelectionSet, schema.Type(fragment.TypeCondition.Name.Value), depth, visiting)
+			delete(visiting, fragment.Name.Value)
+		}
+
+		if selectionDepth > maxDepth {
+			maxDepth = selectionDepth
+		}
+		complexity += cost
+	}
+	return maxDepth, complexity
+}
+
+func (a *queryAnalyzer) field(field *ast.Field, parent graphql.Type, depth int, visiting map[string]bool) (int, int) {
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return depth, 0
+	}
+
+	object, ok := parent.(*graphql.Object)
+	if !ok {
+		return depth, 0
+	}
+	definition, ok := object.Fields()[field.Name.Value]
+	if !ok {
+		return depth, 0
+	}
+
+	cost, ok := fieldCosts[object.Name()+"."+field.Name.Value]
+	if !ok {
+		cost = 1
+	}
+
+	if field.SelectionSet == nil {
+		return depth, cost
+	}
+
+	child, _ := graphql.GetNamed(definition.Type).(graphql.Type)
+	childDepth, childComplexity := a.selectionSet(field.SelectionSet, child, depth+1, visiting)
+	return childDepth, cost + a.multiplier(field, definition)*childComplexity
+}
+
+// Selections below a list are repeated for every element: as many as the first argument asks for,
+// the default page size for paginated fields, or defaultListSize when the list is not bounded at all.
+func (a *queryAnalyzer) multiplier(field *ast.Field, definition *graphql.FieldDefinition) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "first" {
+			continue
+		}
+		if first, ok := a.intValue(argument.Value); ok && first > 0 {
+			return first
+		}
+	}
+	for _, argument := range definition.Args {
+		if argument.Name() == "first" {
+			return defaultPageSize
+		}
+	}
+
+	fieldType := definition.Type
+	if nonNull, ok := fieldType.(*graphql.NonNull); ok {
+		fieldType = nonNull.OfType
+	}
+	if _, ok := fieldType.(*graphql.List); ok {
+		return defaultListSize
+	}
+	return 1
+}
+
+func (a *queryAnalyzer) intValue(value ast.Value) (int, bool) {
+	switch value := value.(type) {
+	case *ast.IntValue:
+		number, err := strconv.Atoi(value.Value)
+		return number, err == nil
+	case *ast.Variable:
+		switch number := a.variables[value.Name.Value].(type) {
+		case float64:
+			return int(number), true
+		case int:
+			return number, true
+		}
+	}
+	return 0, false
+}
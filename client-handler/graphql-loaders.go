@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Matches the limit of the BatchGet RPCs.
+const maxLoaderBatch = 100
+
+const loadersContextKey contextKey = "loaders"
+
+type graphqlLoaders struct {
+	lifeguards          *Loader[int64, *GetLifeguardResponse]
+	vehicles            *Loader[int64, *GetVehicleResponse]
+	vehiclesByLifeguard *Loader[int64, []*GetVehicleResponse]
+	incidents           *Loader[string, *IncidentProto]
+}
+
+func newGraphQLLoaders() *graphqlLoaders {
+	return &graphqlLoaders{
+		lifeguards: NewLoader(maxLoaderBatch, batchGetLifeguards, func(id int64) error {
+			return status.Errorf(codes.NotFound, "Ratownik o ID %d nie znaleziony", id)
+		}),
+		vehicles: NewLoader(maxLoaderBatch, batchGetVehicles, func(id int64) error {
+			return status.Errorf(codes.NotFound, "Pojazd o ID %d nie znaleziony", id)
+		}),
+		vehiclesByLifeguard: NewLoader(maxLoaderBatch, listVehiclesByLifeguards, nil),
+		incidents: NewLoader(maxLoaderBatch, batchGetIncidents, func(incidentID string) error {
+			return status.Errorf(codes.NotFound, "Nie znaleziono incydentu o ID: %s", incidentID)
+		}),
+	}
+}
+
+func withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersContextKey, newGraphQLLoaders())
+}
+
+// Subscriptions resolve every event with the same context, so they get no shared loaders
+// and each resolver fetches fresh data.
+func loadersFrom(ctx context.Context) *graphqlLoaders {
+	if loaders, ok := ctx.Value(loadersContextKey).(*graphqlLoaders); ok {
+		return loaders
+	}
+	return newGraphQLLoaders()
+}
+
+// resolveLoaded adapts a loader thunk to a graphql-go resolver result.
+func resolveLoaded[V any](service string, load func() (V, error)) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		value, err := load()
+		if err != nil {
+			return nil, graphqlServiceError(service, err)
+		}
+		return value, nil
+	}
+}
+
+func batchGetLifeguards(ctx context.Context, ids []int64) (map[int64]*GetLifeguardResponse, error) {
+	resp, err := lifeguardClient.BatchGetLifeguards(ctx, &BatchGetLifeguardsRequest{Ids: ids})
+	if err != nil {
+		log.Printf("Nie udało się pobrać ratowników o id: %v, error: %v\n", ids, err)
+		return nil, err
+	}
+
+	lifeguards := make(map[int64]*GetLifeguardResponse, len(resp.Lifeguards))
+	for _, lifeguard := range resp.Lifeguards {
+		lifeguards[lifeguard.Id] = lifeguard
+	}
+	log.Printf("Pobrano %d ratowników w jednym zapytaniu\n", len(lifeguards))
+	return lifeguards, nil
+}
+
+func batchGetVehicles(ctx context.Context, ids []int64) (map[int64]*GetVehicleResponse, error) {
+	resp, err := vehicleClient.BatchGetVehicles(ctx, &BatchGetVehiclesRequest{Ids: ids})
+	if err != nil {
+		log.Printf("Nie udało się pobrać pojazdów o id: %v, error: %v\n", ids, err)
+		return nil, err
+	}
+
+	vehicles := make(map[int64]*GetVehicleResponse, len(resp.Vehicles))
+	for _, vehicle := range resp.Vehicles {
+		vehicles[vehicle.Id] = vehicle
+	}
+	log.Printf("Pobrano %d pojazdów w jednym zapytaniu\n", len(vehicles))
+	return vehicles, nil
+}
+
+func listVehiclesByLifeguards(ctx context.Context, lifeguardIDs []int64) (map[int64][]*GetVehicleResponse, error) {
+	resp, err := vehicleClient.ListVehicles(ctx, &ListVehiclesRequest{LifeguardInChargeIds: lifeguardIDs})
+	if err != nil {
+		log.Printf("Nie udało się pobrać pojazdów ratowników o id: %v, error: %v\n", lifeguardIDs, err)
+		return nil, err
+	}
+
+	vehicles := make(map[int64][]*GetVehicleResponse, len(lifeguardIDs))
+	for _, vehicle := range resp.Vehicles {
+		vehicles[vehicle.LifeguardInChargeId] = append(vehicles[vehicle.LifeguardInChargeId], vehicle)
+	}
+	log.Printf("Pobrano %d pojazdów %d ratowników w jednym zapytaniu\n", len(resp.Vehicles), len(lifeguardIDs))
+	return vehicles, nil
+}
+
+func batchGetIncidents(ctx context.Context, incidentIDs []string) (map[string]*IncidentProto, error) {
+	resp, err := incidentClient.BatchGetIncidents(ctx, &BatchGetIncidentsRequest{IncidentIds: incidentIDs})
+	if err != nil {
+		log.Printf("Nie udało się pobrać incydentów o ID: %v, error: %v\n", incidentIDs, err)
+		return nil, err
+	}
+
+	incidents := make(map[string]*IncidentProto, len(resp.Incidents))
+	for _, incident := range resp.Incidents {
+		incidents[incident.IncidentID] = incident
+	}
+	log.Printf("Pobrano %d incydentów w jednym zapytaniu\n", len(incidents))
+	return incidents, nil
+}
@@ -0,0 +1,318 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/szbobrowski/master-thesis/auth"
+)
+
+// Both WebSocket subprotocols for GraphQL are supported: graphql-transport-ws of the graphql-ws library
+// and the older graphql-ws of subscriptions-transport-ws, which still many clients use.
+const (
+	transportWSProtocol = "graphql-transport-ws"
+	legacyWSProtocol    = "graphql-ws"
+
+	connectionInitTimeout = 10 * time.Second
+	keepAliveInterval     = 15 * time.Second
+	wsWriteTimeout        = 5 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	Subprotocols: []string{transportWSProtocol, legacyWSProtocol},
+}
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type wsConnection struct {
+	conn     *websocket.Conn
+	legacy   bool
+	ctx      context.Context
+	writeMu  sync.Mutex
+	mu       sync.Mutex
+	acked    bool
+	active   map[string]context.CancelFunc
+	finished sync.WaitGroup
+}
+
+func graphqlWebSocketHandler(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("Nie udało się nawiązać połączenia WebSocket: %v\n", err)
+		return
+	}
+	defer conn.Close()
+
+	if conn.Subprotocol() == "" {
+		closeWebSocket(conn, websocket.CloseProtocolError, "Nieobsługiwany podprotokół WebSocket")
+		return
+	}
+
+	// The connection outlives the upgrade request, only the identity of the caller is taken from it.
+	base := context.Background()
+	if claims, ok := auth.FromContext(r.Context()); ok {
+		base = auth.NewContext(auth.WithToken(base, auth.TokenFromContext(r.Context())), claims)
+	}
+	ctx, cancel := context.WithCancel(base)
+	c := &wsConnection{
+		conn:   conn,
+		legacy: conn.Subprotocol() == legacyWSProtocol,
+		ctx:    ctx,
+		active: map[string]context.CancelFunc{},
+	}
+	defer c.finished.Wait()
+	defer cancel()
+
+	log.Printf("Nawiązano połączenie WebSocket GraphQL (%s)\n", conn.Subprotocol())
+	c.readLoop()
+	log.Println("Zamknięto połączenie WebSocket GraphQL")
+}
+
+func closeWebSocket(conn *websocket.Conn, code int, reason string) {
+	deadline := time.Now().Add(wsWriteTimeout)
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), deadline)
+}
+
+func (c *wsConnection) send(message wsMessage) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return c.conn.WriteJSON(message)
+}
+
+func (c *wsConnection) sendPayload(id, messageType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return c.send(wsMessage{ID: id, Type: messageType, Payload: data})
+}
+
+func (c *wsConnection) readLoop() {
+	initTimer := time.AfterFunc(connectionInitTimeout, func() {
+		c.mu.Lock()
+		acked := c.acked
+		c.mu.Unlock()
+		if !acked {
+			closeWebSocket(c.conn, 4408, "Przekroczono czas oczekiwania na connection_init")
+			c.conn.Close()
+		}
+	})
+	defer initTimer.Stop()
+
+	for {
+		var message wsMessage
+		if err := c.conn.ReadJSON(&message); err != nil {
+			return
+		}
+
+		switch message.Type {
+		case "connection_init":
+			if err := c.authenticate(message.Payload); err != nil {
+				closeWebSocket(c.conn, 4403, err.Error())
+				return
+			}
+			if !c.acknowledge() {
+				closeWebSocket(c.conn, 4429, "Zbyt wiele żądań connection_init")
+				return
+			}
+
+		case "ping":
+			c.send(wsMessage{Type: "pong", Payload: message.Payload})
+
+		case "pong":
+
+		case "subscribe", "start":
+			if !c.isAcknowledged() {
+				closeWebSocket(c.conn, 4401, "Brak autoryzacji")
+				return
+			}
+			if !c.start(message) {
+				closeWebSocket(c.conn, 4409, fmt.Sprintf("Subskrypcja %s już istnieje", message.ID))
+				return
+			}
+
+		case "complete", "stop":
+			c.stop(message.ID)
+
+		case "connection_terminate":
+			return
+
+		default:
+			closeWebSocket(c.conn, 4400, fmt.Sprintf("Nieznany typ wiadomości: %s", message.Type))
+			return
+		}
+	}
+}
+
+// A connection opened without the Authorization header has to send its token in the connection_init payload.
+func (c *wsConnection) authenticate(payload json.RawMessage) error {
+	token := tokenFromConnectionInit(payload)
+	if token == "" {
+		if _, ok := auth.FromContext(c.ctx); ok {
+			return nil
+		}
+		return errors.New("Brak tokenu dostępu")
+	}
+
+	ctx, err := authenticatedContext(c.ctx, token)
+	if err != nil {
+		return err
+	}
+	c.ctx = ctx
+	return nil
+}
+
+func (c *wsConnection) acknowledge() bool {
+	c.mu.Lock()
+	if c.acked {
+		c.mu.Unlock()
+		return false
+	}
+	c.acked = true
+	c.mu.Unlock()
+
+	c.send(wsMessage{Type: "connection_ack"})
+	if c.legacy {
+		c.send(wsMessage{Type: "ka"})
+		go c.keepAlive()
+	}
+	return true
+}
+
+func (c *wsConnection) isAcknowledged() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.acked
+}
+
+// The older protocol expects periodic "ka" messages from the server.
+func (c *wsConnection) keepAlive() {
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.send(wsMessage{Type: "ka"}); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *wsConnection) start(message wsMessage) bool {
+	var request graphqlRequest
+	if err := json.Unmarshal(message.Payload, &request); err != nil {
+		c.sendError(message.ID, fmt.Errorf("Niepoprawna treść subskrypcji: %v", err))
+		return true
+	}
+
+	c.mu.Lock()
+	if _, exists := c.active[message.ID]; exists {
+		c.mu.Unlock()
+		return false
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.active[message.ID] = cancel
+	c.mu.Unlock()
+
+	c.finished.Add(1)
+	go c.execute(ctx, message.ID, request)
+	return true
+}
+
+func (c *wsConnection) stop(id string) {
+	c.mu.Lock()
+	cancel, ok := c.active[id]
+	delete(c.active, id)
+	c.mu.Unlock()
+
+	if ok {
+		cancel()
+	}
+}
+
+func (c *wsConnection) execute(ctx context.Context, id string, request graphqlRequest) {
+	defer c.finished.Done()
+	defer c.stop(id)
+
+	if _, err := prepareGraphQLRequest(&request); err != nil {
+		c.sendError(id, err)
+		return
+	}
+
+	log.Printf("Otrzymano operację GraphQL przez WebSocket: %s\n", request.Query)
+
+	params := graphql.Params{
+		Schema:         schema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        ctx,
+	}
+
+	var results <-chan *graphql.Result
+	if operationType(request) == ast.OperationTypeSubscription {
+		results = graphql.Subscribe(params)
+	} else {
+		params.Context = withLoaders(ctx)
+		single := make(chan *graphql.Result, 1)
+		single <- graphql.Do(params)
+		close(single)
+		results = single
+	}
+
+	// The channel is drained after cancellation, so that the executor can finish.
+	for result := range results {
+		if ctx.Err() != nil {
+			continue
+		}
+		if result.Data == nil && len(result.Errors) > 0 {
+			c.sendPayload(id, "error", c.errorPayload(result))
+			c.stop(id)
+			continue
+		}
+		c.sendPayload(id, c.dataMessageType(), result)
+	}
+
+	if ctx.Err() == nil {
+		c.send(wsMessage{ID: id, Type: "complete"})
+	}
+}
+
+func (c *wsConnection) sendError(id string, err error) {
+	result := &graphql.Result{}
+	result.Errors = append(result.Errors, formatGraphQLError(err))
+	c.sendPayload(id, "error", c.errorPayload(result))
+}
+
+// graphql-transport-ws sends a list of errors, the older protocol a single error object.
+func (c *wsConnection) errorPayload(result *graphql.Result) interface{} {
+	if c.legacy && len(result.Errors) > 0 {
+		return result.Errors[0]
+	}
+	return result.Errors
+}
+
+func (c *wsConnection) dataMessageType() string {
+	if c.legacy {
+		return "data"
+	}
+	return "next"
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultCallTimeout      = 2 * time.Second
+	defaultMaxAttempts      = 3
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 10 * time.Second
+
+	retryBaseDelay = 100 * time.Millisecond
+	retryMaxDelay  = 2 * time.Second
+)
+
+// Calls without side effects, repeated when a backend is briefly unavailable.
+var idempotentMethodPrefixes = []string{"Get", "List", "BatchGet"}
+
+var idempotentMethods = map[string]bool{
+	"/main.ApiKeyService/AuthenticateApiKey": true,
+}
+
+// ClientPolicy decides how long calls to the backends may take, how often they are repeated
+// and when a backend is considered down.
+type ClientPolicy struct {
+	Timeout          time.Duration
+	MethodTimeouts   map[string]time.Duration
+	MaxAttempts      int
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+// The policy is set with the GRPC_TIMEOUT, GRPC_METHOD_TIMEOUTS, GRPC_MAX_ATTEMPTS, GRPC_BREAKER_THRESHOLD
+// and GRPC_BREAKER_COOLDOWN environment variables. GRPC_METHOD_TIMEOUTS is a comma separated list of
+// method=duration pairs, e.g. "/main.IncidentService/CreateIncident=5s,/main.VehicleService/*=500ms".
+// GRPC_MAX_ATTEMPTS=1 disables retries and GRPC_BREAKER_THRESHOLD=0 the circuit breaker.
+func clientPolicyFromEnv() (ClientPolicy, error) {
+	policy := ClientPolicy{
+		Timeout:          defaultCallTimeout,
+		MethodTimeouts:   map[string]time.Duration{},
+		MaxAttempts:      defaultMaxAttempts,
+		BreakerThreshold: defaultBreakerThreshold,
+		BreakerCooldown:  defaultBreakerCooldown,
+	}
+
+	for name, duration := range map[string]*time.Duration{
+		"GRPC_TIMEOUT":          &policy.Timeout,
+		"GRPC_BREAKER_COOLDOWN": &policy.BreakerCooldown,
+	} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return policy, fmt.Errorf("Niepoprawna wartość zmiennej %s: %q", name, value)
+		}
+		*duration = parsed
+	}
+
+	for name, limit := range map[string]struct {
+		value *int
+		min   int
+	}{
+		"GRPC_MAX_ATTEMPTS":      {&policy.MaxAttempts, 1},
+		"GRPC_BREAKER_THRESHOLD": {&policy.BreakerThreshold, 0},
+	} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil || number < limit.min {
+			return policy, fmt.Errorf("Niepoprawna wartość zmiennej %s: %q", name, value)
+		}
+		*limit.value = number
+	}
+
+	for _, entry := range strings.Split(os.Getenv("GRPC_METHOD_TIMEOUTS"), ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		method, value, ok := strings.Cut(entry, "=")
+		method = strings.TrimSpace(method)
+		timeout, err := time.ParseDuration(strings.TrimSpace(value))
+		if !ok || !strings.HasPrefix(method, "/") || err != nil || timeout <= 0 {
+			return policy, fmt.Errorf("Niepoprawny wpis zmiennej GRPC_METHOD_TIMEOUTS: %q", entry)
+		}
+		policy.MethodTimeouts[method] = timeout
+	}
+
+	return policy, nil
+}
+
+// timeout of a method, given for the method itself, for its whole service ("/main.VehicleService/*") or by default.
+func (p ClientPolicy) timeout(method string) time.Duration {
+	if timeout, ok := p.MethodTimeouts[method]; ok {
+		return timeout
+	}
+	if i := strings.LastIndex(method, "/"); i > 0 {
+		if timeout, ok := p.MethodTimeouts[method[:i]+"/*"]; ok {
+			return timeout
+		}
+	}
+	return p.Timeout
+}
+
+func (p ClientPolicy) attempts(method string) int {
+	if isIdempotent(method) {
+		return p.MaxAttempts
+	}
+	return 1
+}
+
+func isIdempotent(method string) bool {
+	if idempotentMethods[method] {
+		return true
+	}
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range idempotentMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Backend is a connection to one of the gRPC services together with its circuit breaker.
+type Backend struct {
+	Name    string
+	Address string
+	Conn    *grpc.ClientConn
+
+	policy  ClientPolicy
+	breaker *circuitBreaker
+	// connected tells whether the last connection attempt succeeded, see watchConnection.
+	connected atomic.Bool
+}
+
+// dialBackend does not wait for the connection, so client-handler starts while a backend is down
+// and requests to it fail until it comes up, see the /readyz endpoint.
+func dialBackend(name, address string, policy ClientPolicy) (*Backend, error) {
+	b := &Backend{
+		Name:    name,
+		Address: address,
+		policy:  policy,
+		breaker: newCircuitBreaker(name, policy.BreakerThreshold, policy.BreakerCooldown),
+	}
+
+	options := append(grpcAuthOptions(),
+		grpcCredentials.DialOption(name),
+		grpc.WithChainUnaryInterceptor(b.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(b.streamInterceptor),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  retryBaseDelay,
+				Multiplier: 1.6,
+				Jitter:     0.2,
+				MaxDelay:   5 * time.Second,
+			},
+			MinConnectTimeout: 5 * time.Second,
+		}),
+	)
+	conn, err := grpc.NewClient(address, options...)
+	if err != nil {
+		return nil, err
+	}
+	conn.Connect()
+	b.Conn = conn
+	go b.watchConnection()
+
+	log.Printf("Klient gRPC usługi %s łączy się z adresem %s\n", name, address)
+	return b, nil
+}
+
+// watchConnection follows the state of the connection until it is closed. An idle connection keeps
+// the result of the attempt before it, so readiness can tell a connection closed for lack of calls from
+// one that never came up.
+func (b *Backend) watchConnection() {
+	state := b.Conn.GetState()
+	for {
+		switch state {
+		case connectivity.Ready:
+			b.connected.Store(true)
+		case connectivity.TransientFailure:
+			b.connected.Store(false)
+		case connectivity.Shutdown:
+			return
+		}
+		if !b.Conn.WaitForStateChange(context.Background(), state) {
+			return
+		}
+		state = b.Conn.GetState()
+	}
+}
+
+func (b *Backend) Close() error {
+	return b.Conn.Close()
+}
+
+// unaryInterceptor sets the deadline of the method, which covers all attempts, fails fast while the circuit
+// is open and repeats idempotent calls with exponential backoff. The circuit breaker sees the outcome of the
+// whole call, so that the retries of one call do not count as several failures.
+func (b *Backend) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, cancel := context.WithTimeout(ctx, b.policy.timeout(method))
+	defer cancel()
+
+	attempts := b.policy.attempts(method)
+	var err error
+retries:
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(retryDelay(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				break retries
+			case <-timer.C:
+			}
+		}
+
+		if !b.breaker.allow() {
+			if err != nil {
+				break
+			}
+			return b.breaker.rejection()
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		if !isRetryable(err) {
+			break
+		}
+	}
+	b.breaker.record(ctx, err)
+	return err
+}
+
+// Streams are long-lived, so they get no deadline; only opening one is subject to the circuit breaker.
+func (b *Backend) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if !b.breaker.allow() {
+		return nil, b.breaker.rejection()
+	}
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	b.breaker.record(ctx, err)
+	return stream, err
+}
+
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryDelay doubles with every attempt up to retryMaxDelay, randomized so that clients do not retry in step.
+func retryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay << (attempt - 1)
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+type graphqlFieldError struct {
+	message    string
+	violations []FieldViolation
+}
+
+func (e *graphqlFieldError) Error() string {
+	return e.message
+}
+
+func (e *graphqlFieldError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":            "BAD_USER_INPUT",
+		"fieldViolations": e.violations,
+	}
+}
+
+func fieldViolations(st *status.Status) []FieldViolation {
+	var violations []FieldViolation
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.FieldViolations {
+			violations = append(violations, FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+	}
+	return violations
+}
+
+func graphqlError(err error) error {
+	return graphqlServiceError("incident-notifier", err)
+}
+
+func graphqlServiceError(service string, err error) error {
+	st, _ := status.FromError(err)
+	if httpStatusFromGrpc(st.Code()) >= http.StatusInternalServerError {
+		log.Printf("Błąd serwera %s, error: %v\n", service, err)
+		return fmt.Errorf("Błąd z serwera %s", service)
+	}
+	message := fmt.Sprintf("Błąd z serwera %s: %v", service, st.Message())
+	if st.Code() != codes.InvalidArgument {
+		return fmt.Errorf("%s", message)
+	}
+
+	violations := fieldViolations(st)
+	for i := range violations {
+		violations[i].Field = graphqlFieldName(violations[i].Field)
+	}
+	return &graphqlFieldError{message: message, violations: violations}
+}
+
+func graphqlFieldName(protoField string) string {
+	parts := strings.Split(protoField, "_")
+	for i := 1; i < len(parts); i++ {
+		switch parts[i] {
+		case "":
+			continue
+		case "id":
+			parts[i] = "ID"
+			continue
+		}
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	problemMediaType = "application/problem+json"
+
+	requestIDHeader                = "X-Request-ID"
+	requestIDContextKey contextKey = "requestID"
+
+	defaultLanguage = "pl"
+)
+
+// Problem is an RFC 9457 error body. Detail carries the message of the backend, left out for server errors
+// since it may describe internals, Message the same error described in the language requested with Accept-Language.
+type Problem struct {
+	Type            string           `json:"type"`
+	Title           string           `json:"title"`
+	Status          int              `json:"status"`
+	Detail          string           `json:"detail,omitempty"`
+	Instance        string           `json:"instance,omitempty"`
+	Code            string           `json:"code"`
+	Message         string           `json:"message"`
+	FieldViolations []FieldViolation `json:"field_violations,omitempty"`
+	RequestID       string           `json:"request_id,omitempty"`
+}
+
+var grpcHTTPStatuses = map[codes.Code]int{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+}
+
+// Errors raised in client-handler itself get the code of the gRPC status they correspond to.
+var httpStatusCodes = map[int]string{
+	http.StatusBadRequest:            "INVALID_ARGUMENT",
+	http.StatusUnauthorized:          "UNAUTHENTICATED",
+	http.StatusForbidden:             "PERMISSION_DENIED",
+	http.StatusNotFound:              "NOT_FOUND",
+	http.StatusMethodNotAllowed:      "METHOD_NOT_ALLOWED",
+	http.StatusConflict:              "ALREADY_EXISTS",
+	http.StatusRequestEntityTooLarge: "PAYLOAD_TOO_LARGE",
+	http.StatusUnsupportedMediaType:  "UNSUPPORTED_MEDIA_TYPE",
+	http.StatusTooManyRequests:       "RESOURCE_EXHAUSTED",
+	http.StatusNotImplemented:        "UNIMPLEMENTED",
+	http.StatusServiceUnavailable:    "UNAVAILABLE",
+	http.StatusGatewayTimeout:        "DEADLINE_EXCEEDED",
+}
+
+var problemMessages = map[string]map[string]string{
+	"INVALID_ARGUMENT": {
+		"pl": "Zapytanie zawiera niepoprawne dane",
+		"en": "The request contains invalid data",
+	},
+	"OUT_OF_RANGE": {
+		"pl": "Wartość spoza dopuszczalnego zakresu",
+		"en": "A value is out of the allowed range",
+	},
+	"FAILED_PRECONDITION": {
+		"pl": "Operacja nie może zostać wykonana w obecnym stanie zasobu",
+		"en": "The operation cannot be performed in the current state of the resource",
+	},
+	"UNAUTHENTICATED": {
+		"pl": "Wymagane jest uwierzytelnienie",
+		"en": "Authentication is required",
+	},
+	"PERMISSION_DENIED": {
+		"pl": "Brak uprawnień do wykonania operacji",
+		"en": "You are not allowed to perform this operation",
+	},
+	"NOT_FOUND": {
+		"pl": "Nie znaleziono zasobu",
+		"en": "The resource was not found",
+	},
+	"METHOD_NOT_ALLOWED": {
+		"pl": "Metoda niedozwolona dla tego zasobu",
+		"en": "The method is not allowed for this resource",
+	},
+	"ALREADY_EXISTS": {
+		"pl": "Zasób już istnieje",
+		"en": "The resource already exists",
+	},
+	"ABORTED": {
+		"pl": "Operacja została przerwana z powodu konfliktu, spróbuj ponownie",
+		"en": "The operation was aborted because of a conflict, try again",
+	},
+	"PAYLOAD_TOO_LARGE": {
+		"pl": "Treść zapytania jest zbyt duża",
+		"en": "The request body is too large",
+	},
+	"UNSUPPORTED_MEDIA_TYPE": {
+		"pl": "Nieobsługiwany typ treści",
+		"en": "The content type is not supported",
+	},
+	"RESOURCE_EXHAUSTED": {
+		"pl": "Przekroczono limit zapytań",
+		"en": "The request limit was exceeded",
+	},
+	"UNIMPLEMENTED": {
+		"pl": "Operacja nie jest obsługiwana",
+		"en": "The operation is not supported",
+	},
+	"UNAVAILABLE": {
+		"pl": "Usługa jest chwilowo niedostępna",
+		"en": "The service is temporarily unavailable",
+	},
+	"DEADLINE_EXCEEDED": {
+		"pl": "Przekroczono czas oczekiwania na odpowiedź usługi",
+		"en": "The service did not respond in time",
+	},
+	"INTERNAL": {
+		"pl": "Wystąpił błąd wewnętrzny",
+		"en": "An internal error occurred",
+	},
+}
+
+func httpStatusFromGrpc(code codes.Code) int {
+	if httpStatus, ok := grpcHTTPStatuses[code]; ok {
+		return httpStatus
+	}
+	return http.StatusInternalServerError
+}
+
+// grpcCodeName turns codes.NotFound into NOT_FOUND, the name used by google.rpc.Code.
+func grpcCodeName(code codes.Code) string {
+	name := code.String()
+	var builder strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			builder.WriteByte('_')
+		}
+		builder.WriteRune(r)
+	}
+	return strings.ToUpper(builder.String())
+}
+
+func writeGrpcError(w http.ResponseWriter, r *http.Request, err error) {
+	st, _ := status.FromError(err)
+	httpStatus := httpStatusFromGrpc(st.Code())
+	code := grpcCodeName(st.Code())
+	if httpStatus == http.StatusInternalServerError {
+		code = "INTERNAL"
+	}
+
+	detail := st.Message()
+	if httpStatus >= http.StatusInternalServerError {
+		log.Printf("Błąd serwera gRPC podczas obsługi zapytania %s %s, request_id: %s, error: %v\n", r.Method, r.URL.Path, requestIDFrom(r.Context()), err)
+		detail = ""
+	}
+
+	language := preferredLanguage(r)
+	problem := newProblem(r, httpStatus, code, detail, language)
+	problem.FieldViolations = fieldViolations(st)
+	if message := localizedDetail(st, language); message != "" {
+		problem.Message = message
+	}
+	writeProblem(w, problem)
+}
+
+func writeJSONError(w http.ResponseWriter, r *http.Request, httpStatus int, message string) {
+	code, ok := httpStatusCodes[httpStatus]
+	if !ok {
+		code = "INTERNAL"
+	}
+	writeProblem(w, newProblem(r, httpStatus, code, message, preferredLanguage(r)))
+}
+
+func newProblem(r *http.Request, httpStatus int, code, detail, language string) Problem {
+	return Problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(httpStatus),
+		Status:    httpStatus,
+		Detail:    detail,
+		Instance:  r.URL.Path,
+		Code:      code,
+		Message:   problemMessages[code][language],
+		RequestID: requestIDFrom(r.Context()),
+	}
+}
+
+func writeProblem(w http.ResponseWriter, problem Problem) {
+	w.Header().Set("Content-Type", problemMediaType)
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(problem)
+}
+
+// A backend may describe the error itself with google.rpc.LocalizedMessage, which then wins over the generic message.
+func localizedDetail(st *status.Status, language string) string {
+	for _, detail := range st.Details() {
+		localized, ok := detail.(*errdetails.LocalizedMessage)
+		if ok && primaryLanguage(localized.Locale) == language {
+			return localized.Message
+		}
+	}
+	return ""
+}
+
+// preferredLanguage picks the first language of Accept-Language that has messages, quality values are
+// not weighed since clients list languages in order of preference anyway.
+func preferredLanguage(r *http.Request) string {
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		language := primaryLanguage(strings.TrimSpace(strings.Split(part, ";")[0]))
+		if _, ok := problemMessages["INTERNAL"][language]; ok {
+			return language
+		}
+	}
+	return defaultLanguage
+}
+
+func primaryLanguage(tag string) string {
+	return strings.ToLower(strings.SplitN(strings.ReplaceAll(tag, "_", "-"), "-", 2)[0])
+}
+
+// withRequestID gives every request an ID, taken from X-Request-ID when the client sends one,
+// and returns it in the response header and error bodies.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(requestIDHeader)
+		if requestID == "" || len(requestID) > 128 {
+			requestID = newRequestID()
+		}
+
+		w.Header().Set(requestIDHeader, requestID)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDContextKey, requestID)))
+	})
+}
+
+func requestIDFrom(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey).(string)
+	return requestID
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,146 @@
+package main
+
+import "github.com/graphql-go/graphql"
+
+var incidentSeverityEnum = graphql.NewEnum(
+	graphql.EnumConfig{
+		Name: "IncidentSeverity",
+		Values: graphql.EnumValueConfigMap{
+			"LOW": &graphql.EnumValueConfig{
+				Value: IncidentSeverity_INCIDENT_SEVERITY_LOW,
+			},
+			"MODERATE": &graphql.EnumValueConfig{
+				Value: IncidentSeverity_INCIDENT_SEVERITY_MODERATE,
+			},
+			"HIGH": &graphql.EnumValueConfig{
+				Value: IncidentSeverity_INCIDENT_SEVERITY_HIGH,
+			},
+			"CRITICAL": &graphql.EnumValueConfig{
+				Value:       IncidentSeverity_INCIDENT_SEVERITY_CRITICAL,
+				Description: "Zagrożenie życia",
+			},
+		},
+	},
+)
+
+var incidentCategoryEnum = graphql.NewEnum(
+	graphql.EnumConfig{
+		Name: "IncidentCategory",
+		Values: graphql.EnumValueConfigMap{
+			"DROWNING": &graphql.EnumValueConfig{
+				Value: IncidentCategory_INCIDENT_CATEGORY_DROWNING,
+			},
+			"INJURY": &graphql.EnumValueConfig{
+				Value: IncidentCategory_INCIDENT_CATEGORY_INJURY,
+			},
+			"LOST_PERSON": &graphql.EnumValueConfig{
+				Value: IncidentCategory_INCIDENT_CATEGORY_LOST_PERSON,
+			},
+			"HAZARD": &graphql.EnumValueConfig{
+				Value: IncidentCategory_INCIDENT_CATEGORY_HAZARD,
+			},
+			"OTHER": &graphql.EnumValueConfig{
+				Value: IncidentCategory_INCIDENT_CATEGORY_OTHER,
+			},
+		},
+	},
+)
+
+var locationType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "Location",
+		Fields: graphql.Fields{
+			"latitude": &graphql.Field{
+				Type: graphql.Float,
+			},
+			"longitude": &graphql.Field{
+				Type: graphql.Float,
+			},
+			"zone": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	},
+)
+
+var reporterContactType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "ReporterContact",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"phone": &graphql.Field{
+				Type: graphql.String,
+			},
+			"email": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	},
+)
+
+var locationInput = graphql.NewInputObject(
+	graphql.InputObjectConfig{
+		Name: "LocationInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"latitude": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Float),
+			},
+			"longitude": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Float),
+			},
+			"zone": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	},
+)
+
+var reporterContactInput = graphql.NewInputObject(
+	graphql.InputObjectConfig{
+		Name: "ReporterContactInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"phone": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"email": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	},
+)
+
+func locationFromArgs(args map[string]interface{}) *Location {
+	if args == nil {
+		return nil
+	}
+	latitude, _ := args["latitude"].(float64)
+	longitude, _ := args["longitude"].(float64)
+	zone, _ := args["zone"].(string)
+	return &Location{Latitude: latitude, Longitude: longitude, Zone: zone}
+}
+
+func reporterFromArgs(args map[string]interface{}) *ReporterContact {
+	if args == nil {
+		return nil
+	}
+	name, _ := args["name"].(string)
+	phone, _ := args["phone"].(string)
+	email, _ := args["email"].(string)
+	return &ReporterContact{Name: name, Phone: phone, Email: email}
+}
+
+func int64List(value interface{}) []int64 {
+	items, _ := value.([]interface{})
+	var ids []int64
+	for _, item := range items {
+		if id, ok := item.(int); ok {
+			ids = append(ids, int64(id))
+		}
+	}
+	return ids
+}
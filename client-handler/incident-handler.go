@@ -1,22 +1,51 @@
 package main
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
 	"log"
-	"net/http"
-	"time"
 
 	"github.com/graphql-go/graphql"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/status"
 )
 
-const grpcAddress = "localhost:50052"
-
 var incidentClient IncidentServiceClient
 
+type contextKey string
+
+const idempotencyKeyContextKey contextKey = "idempotencyKey"
+
+var incidentStatusEnum = graphql.NewEnum(
+	graphql.EnumConfig{
+		Name: "IncidentStatus",
+		Values: graphql.EnumValueConfigMap{
+			"UNKNOWN": &graphql.EnumValueConfig{
+				Value:       IncidentStatus_INCIDENT_STATUS_UNSPECIFIED,
+				Description: "Status zapisany w starszym formacie, którego nie udało się znormalizować, zob. legacyStatus",
+			},
+			"REPORTED": &graphql.EnumValueConfig{
+				Value: IncidentStatus_INCIDENT_STATUS_REPORTED,
+			},
+			"ACKNOWLEDGED": &graphql.EnumValueConfig{
+				Value: IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED,
+			},
+			"DISPATCHED": &graphql.EnumValueConfig{
+				Value: IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+			},
+			"ON_SCENE": &graphql.EnumValueConfig{
+				Value: IncidentStatus_INCIDENT_STATUS_ON_SCENE,
+			},
+			"RESOLVED": &graphql.EnumValueConfig{
+				Value: IncidentStatus_INCIDENT_STATUS_RESOLVED,
+			},
+			"CLOSED": &graphql.EnumValueConfig{
+				Value: IncidentStatus_INCIDENT_STATUS_CLOSED,
+			},
+			"CANCELLED": &graphql.EnumValueConfig{
+				Value: IncidentStatus_INCIDENT_STATUS_CANCELLED,
+			},
+		},
+	},
+)
+
 var incidentType = graphql.NewObject(
 	graphql.ObjectConfig{
 		Name: "Incident",
@@ -24,6 +53,10 @@ var incidentType = graphql.NewObject(
 			"incidentID": &graphql.Field{
 				Type: graphql.String,
 			},
+			"shortCode": &graphql.Field{
+				Type:        graphql.String,
+				Description: "Krótki kod incydentu do użycia w łączności radiowej, np. INC-7K3Q",
+			},
 			"title": &graphql.Field{
 				Type: graphql.String,
 			},
@@ -31,11 +64,144 @@ var incidentType = graphql.NewObject(
 				Type: graphql.String,
 			},
 			"status": &graphql.Field{
+				Type: incidentStatusEnum,
+			},
+			"legacyStatus": &graphql.Field{
 				Type: graphql.String,
 			},
 			"creationDate": &graphql.Field{
 				Type: graphql.String,
 			},
+			"statusChangedAt": &graphql.Field{
+				Type: graphql.String,
+			},
+			"acknowledgedAt": &graphql.Field{
+				Type: graphql.String,
+			},
+			"dispatchedAt": &graphql.Field{
+				Type: graphql.String,
+			},
+			"onSceneAt": &graphql.Field{
+				Type: graphql.String,
+			},
+			"resolvedAt": &graphql.Field{
+				Type: graphql.String,
+			},
+			"closedAt": &graphql.Field{
+				Type: graphql.String,
+			},
+			"cancelledAt": &graphql.Field{
+				Type: graphql.String,
+			},
+			"resolutionNote": &graphql.Field{
+				Type: graphql.String,
+			},
+			"cancellationReason": &graphql.Field{
+				Type: graphql.String,
+			},
+			"severity": &graphql.Field{
+				Type: incidentSeverityEnum,
+			},
+			"priority": &graphql.Field{
+				Type:        graphql.Int,
+				Description: "Priorytet od 1 (najpilniejszy) do 5",
+			},
+			"category": &graphql.Field{
+				Type: incidentCategoryEnum,
+			},
+			"location": &graphql.Field{
+				Type: locationType,
+			},
+			"reporter": &graphql.Field{
+				Type: reporterContactType,
+			},
+			"peopleInvolved": &graphql.Field{
+				Type: graphql.Int,
+			},
+			"assignedLifeguardIDs": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+			},
+			"assignedVehicleIDs": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+			},
+			"assignedVehicles": &graphql.Field{
+				Type:    graphql.NewList(graphql.NewNonNull(vehicleType)),
+				Resolve: resolveAssignedVehicles,
+			},
+			"timeline": &graphql.Field{
+				Type:    graphql.NewList(graphql.NewNonNull(timelineEntryType)),
+				Resolve: resolveIncidentTimeline,
+			},
+		},
+	},
+)
+
+var deleteResponseType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "DeleteResponse",
+		Fields: graphql.Fields{
+			"success": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	},
+)
+
+var incidentFilterInput = graphql.NewInputObject(
+	graphql.InputObjectConfig{
+		Name: "IncidentFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"status": &graphql.InputObjectFieldConfig{
+				Type: incidentStatusEnum,
+			},
+			"createdFrom": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"createdTo": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	},
+)
+
+var pageInfoType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+			},
+			"endCursor": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	},
+)
+
+var incidentEdgeType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "IncidentEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+			"node": &graphql.Field{
+				Type: incidentType,
+			},
+		},
+	},
+)
+
+var incidentConnectionType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "IncidentConnection",
+		Fields: graphql.Fields{
+			"edges": &graphql.Field{
+				Type: graphql.NewList(incidentEdgeType),
+			},
+			"pageInfo": &graphql.Field{
+				Type: graphql.NewNonNull(pageInfoType),
+			},
 		},
 	},
 )
@@ -57,23 +223,69 @@ var rootQuery = graphql.NewObject(
 						return nil, fmt.Errorf("Wymagane jest pole incidentID")
 					}
 
-					req := &GetIncidentRequest{
-						IncidentID: incidentID,
+					return resolveLoaded("incident-notifier", loadersFrom(p.Context).incidents.Load(p.Context, incidentID)), nil
+				},
+			},
+
+			"incidents": &graphql.Field{
+				Type: incidentConnectionType,
+				Args: graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{
+						Type: incidentFilterInput,
+					},
+					"first": &graphql.ArgumentConfig{
+						Type: graphql.Int,
+					},
+					"after": &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					req := &ListIncidentsRequest{}
+					if filter, ok := p.Args["filter"].(map[string]interface{}); ok {
+						req.Status, _ = filter["status"].(IncidentStatus)
+						req.CreatedFrom, _ = filter["createdFrom"].(string)
+						req.CreatedTo, _ = filter["createdTo"].(string)
 					}
-					ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-					defer cancel()
+					if first, ok := p.Args["first"].(int); ok {
+						req.PageSize = int32(first)
+					}
+					req.PageToken, _ = p.Args["after"].(string)
 
-					resp, err := incidentClient.GetIncident(ctx, req)
+					resp, err := incidentClient.ListIncidents(p.Context, req)
 					if err != nil {
-						st, _ := status.FromError(err)
-						log.Printf("Nie udało się pobrać incydentu o id: %s, błąd: %v\n", incidentID, st.Message())
-						return nil, fmt.Errorf("Błąd z serwera incident-notifier: %v", st.Message())
+						log.Printf("Nie udało się pobrać listy incydentów, error: %v\n", err)
+						return nil, graphqlError(err)
 					}
 
-					log.Printf("Pobrano incydent: %+v\n", resp.Incident)
-					return resp.Incident, nil
+					edges := make([]map[string]interface{}, 0, len(resp.Incidents))
+					for i, incident := range resp.Incidents {
+						edges = append(edges, map[string]interface{}{
+							"cursor": resp.Cursors[i],
+							"node":   incident,
+						})
+					}
+
+					var endCursor interface{}
+					if len(resp.Cursors) > 0 {
+						endCursor = resp.Cursors[len(resp.Cursors)-1]
+					}
+
+					log.Printf("Pobrano %d incydentów\n", len(resp.Incidents))
+					return map[string]interface{}{
+						"edges": edges,
+						"pageInfo": map[string]interface{}{
+							"hasNextPage": resp.NextPageToken != "",
+							"endCursor":   endCursor,
+						},
+					}, nil
 				},
 			},
+
+			"lifeguard":  lifeguardField,
+			"lifeguards": lifeguardsField,
+			"vehicle":    vehicleField,
+			"vehicles":   vehiclesField,
 		},
 	},
 )
@@ -92,31 +304,77 @@ var rootMutation = graphql.NewObject(
 						Type: graphql.NewNonNull(graphql.String),
 					},
 					"status": &graphql.ArgumentConfig{
-						Type: graphql.NewNonNull(graphql.String),
+						Type: incidentStatusEnum,
 					},
 					"creationDate": &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.String),
 					},
+					"idempotencyKey": &graphql.ArgumentConfig{
+						Type:        graphql.String,
+						Description: "Ponowienie żądania z tym samym kluczem zwraca utworzony wcześniej incydent. Domyślnie nagłówek Idempotency-Key",
+					},
+					"severity": &graphql.ArgumentConfig{
+						Type: incidentSeverityEnum,
+					},
+					"priority": &graphql.ArgumentConfig{
+						Type:        graphql.Int,
+						Description: "Domyślnie wyznaczany na podstawie severity",
+					},
+					"category": &graphql.ArgumentConfig{
+						Type: incidentCategoryEnum,
+					},
+					"location": &graphql.ArgumentConfig{
+						Type: locationInput,
+					},
+					"reporter": &graphql.ArgumentConfig{
+						Type: reporterContactInput,
+					},
+					"peopleInvolved": &graphql.ArgumentConfig{
+						Type: graphql.Int,
+					},
+					"assignedLifeguardIDs": &graphql.ArgumentConfig{
+						Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+					},
+					"assignedVehicleIDs": &graphql.ArgumentConfig{
+						Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					title := p.Args["title"].(string)
 					description := p.Args["description"].(string)
-					status := p.Args["status"].(string)
+					status, _ := p.Args["status"].(IncidentStatus)
 					creationDate := p.Args["creationDate"].(string)
+					idempotencyKey, _ := p.Args["idempotencyKey"].(string)
+					if idempotencyKey == "" {
+						idempotencyKey, _ = p.Context.Value(idempotencyKeyContextKey).(string)
+					}
+					severity, _ := p.Args["severity"].(IncidentSeverity)
+					priority, _ := p.Args["priority"].(int)
+					category, _ := p.Args["category"].(IncidentCategory)
+					location, _ := p.Args["location"].(map[string]interface{})
+					reporter, _ := p.Args["reporter"].(map[string]interface{})
+					peopleInvolved, _ := p.Args["peopleInvolved"].(int)
 
 					req := &CreateIncidentRequest{
-						Title:        title,
-						Description:  description,
-						Status:       status,
-						CreationDate: creationDate,
+						Title:                title,
+						Description:          description,
+						Status:               status,
+						CreationDate:         creationDate,
+						IdempotencyKey:       idempotencyKey,
+						Severity:             severity,
+						Priority:             int32(priority),
+						Category:             category,
+						Location:             locationFromArgs(location),
+						Reporter:             reporterFromArgs(reporter),
+						PeopleInvolved:       int32(peopleInvolved),
+						AssignedLifeguardIds: int64List(p.Args["assignedLifeguardIDs"]),
+						AssignedVehicleIds:   int64List(p.Args["assignedVehicleIDs"]),
 					}
-					ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-					defer cancel()
 
-					resp, err := incidentClient.CreateIncident(ctx, req)
+					resp, err := incidentClient.CreateIncident(p.Context, req)
 					if err != nil {
 						log.Printf("Nie udało się utworzyć incydentu, error: %v\n", err)
-						return nil, fmt.Errorf("Błąd z serwera incident-notifier: %v", err.Error())
+						return nil, graphqlError(err)
 					}
 
 					log.Printf("Utworzono incydent: %+v\n", resp.Incident)
@@ -131,24 +389,47 @@ var rootMutation = graphql.NewObject(
 						Type: graphql.NewNonNull(graphql.String),
 					},
 					"status": &graphql.ArgumentConfig{
-						Type: graphql.NewNonNull(graphql.String),
+						Type:        incidentStatusEnum,
+						Description: "Może zostać pominięty, gdy zmieniają się tylko przypisania",
+					},
+					"resolutionNote": &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+					"cancellationReason": &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+					"assignedLifeguardIDs": &graphql.ArgumentConfig{
+						Type:        graphql.NewList(graphql.NewNonNull(graphql.Int)),
+						Description: "Zastępuje przypisanych ratowników, pusta lista usuwa wszystkie przypisania",
+					},
+					"assignedVehicleIDs": &graphql.ArgumentConfig{
+						Type:        graphql.NewList(graphql.NewNonNull(graphql.Int)),
+						Description: "Zastępuje przypisane pojazdy, pusta lista usuwa wszystkie przypisania",
 					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					incidentID := p.Args["incidentID"].(string)
-					status := p.Args["status"].(string)
+					status, _ := p.Args["status"].(IncidentStatus)
+					resolutionNote, _ := p.Args["resolutionNote"].(string)
+					cancellationReason, _ := p.Args["cancellationReason"].(string)
 
 					req := &UpdateIncidentRequest{
-						IncidentID: incidentID,
-						Status:     status,
+						IncidentID:         incidentID,
+						Status:             status,
+						ResolutionNote:     resolutionNote,
+						CancellationReason: cancellationReason,
+					}
+					if ids, ok := p.Args["assignedLifeguardIDs"]; ok {
+						req.AssignedLifeguards = &AssignedResources{Ids: int64List(ids)}
+					}
+					if ids, ok := p.Args["assignedVehicleIDs"]; ok {
+						req.AssignedVehicles = &AssignedResources{Ids: int64List(ids)}
 					}
-					ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-					defer cancel()
 
-					resp, err := incidentClient.UpdateIncident(ctx, req)
+					resp, err := incidentClient.UpdateIncident(p.Context, req)
 					if err != nil {
 						log.Printf("Nie udało się zaktualizować incydentu o id: %s, error: %v\n", incidentID, err)
-						return nil, fmt.Errorf("Błąd z serwera incident-notifier: %v", err.Error())
+						return nil, graphqlError(err)
 					}
 
 					log.Printf("Zaktualizowano incydent: %+v\n", resp.Incident)
@@ -156,15 +437,10 @@ var rootMutation = graphql.NewObject(
 				},
 			},
 
+			"addIncidentNote": addIncidentNoteField,
+
 			"deleteIncident": &graphql.Field{
-				Type: graphql.NewObject(graphql.ObjectConfig{
-					Name: "DeleteResponse",
-					Fields: graphql.Fields{
-						"success": &graphql.Field{
-							Type: graphql.Boolean,
-						},
-					},
-				}),
+				Type: deleteResponseType,
 				Args: graphql.FieldConfigArgument{
 					"incidentID": &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.String),
@@ -176,13 +452,11 @@ var rootMutation = graphql.NewObject(
 					req := &DeleteIncidentRequest{
 						IncidentID: incidentID,
 					}
-					ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-					defer cancel()
 
-					_, err := incidentClient.DeleteIncident(ctx, req)
+					_, err := incidentClient.DeleteIncident(p.Context, req)
 					if err != nil {
 						log.Printf("Nie udało się usunąć incydentu o id: %s, error: %v\n", incidentID, err)
-						return nil, fmt.Errorf("Błąd z serwera incident-notifier: %v", err.Error())
+						return nil, graphqlError(err)
 					}
 
 					log.Printf("Usunięto incydent o id: %s\n", incidentID)
@@ -191,42 +465,22 @@ var rootMutation = graphql.NewObject(
 					}, nil
 				},
 			},
+
+			"createLifeguard": createLifeguardField,
+			"updateLifeguard": updateLifeguardField,
+			"deleteLifeguard": deleteLifeguardField,
+
+			"createVehicle": createVehicleField,
+			"updateVehicle": updateVehicleField,
+			"deleteVehicle": deleteVehicleField,
 		},
 	},
 )
 
-func initIncidentGrpcClient() {
-	conn, err := grpc.Dial(grpcAddress, grpc.WithInsecure())
-	if err != nil {
-		log.Fatalf("Nie udało się połączyć z serwerem incident-notifier: %v", err)
-	}
-	incidentClient = NewIncidentServiceClient(conn)
-}
-
 var schema, _ = graphql.NewSchema(
 	graphql.SchemaConfig{
-		Query:    rootQuery,
-		Mutation: rootMutation,
+		Query:        rootQuery,
+		Mutation:     rootMutation,
+		Subscription: rootSubscription,
 	},
 )
-
-func graphqlHandler(w http.ResponseWriter, r *http.Request) {
-	var params struct {
-		Query string `json:"query"`
-	}
-	err := json.NewDecoder(r.Body).Decode(&params)
-	if err != nil {
-		log.Println("Błąd podczas dekodowania treści zapytania")
-		http.Error(w, "Błąd podczas dekodowania treści zapytania", http.StatusBadRequest)
-		return
-	}
-
-	log.Printf("Otrzymano kwerendę GraphQL: %s\n", params.Query)
-
-	result := graphql.Do(graphql.Params{
-		Schema:        schema,
-		RequestString: params.Query,
-	})
-
-	json.NewEncoder(w).Encode(result)
-}
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/szbobrowski/master-thesis/auth"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	replayBufferSize     = 256
+	streamClientBuffer   = 64
+	heartbeatInterval    = 15 * time.Second
+	feedReconnectDelay   = time.Second
+	feedMaxReconnectWait = 30 * time.Second
+)
+
+var incidentFeed *IncidentFeed
+
+type feedEvent struct {
+	seq    uint64
+	change *IncidentChange
+}
+
+type incidentStreamFilter struct {
+	changeType IncidentChangeType
+	status     IncidentStatus
+	severity   IncidentSeverity
+	zone       string
+}
+
+type feedClient struct {
+	filter incidentStreamFilter
+	events chan feedEvent
+}
+
+// IncidentFeed keeps a single WatchIncidents stream open for all SSE clients and remembers the latest
+// events, so that a reconnecting client can resume from its Last-Event-ID. The stream is not opened on behalf
+// of any user, so it authenticates with a service token.
+// Event IDs are "<boot>-<seq>": sequence numbers start over with every process, so an ID from another
+// process or one older than the buffer cannot say which events the client has already seen.
+type IncidentFeed struct {
+	client IncidentServiceClient
+	token  auth.TokenSource
+	boot   string
+
+	mu      sync.Mutex
+	lastID  uint64
+	replay  []feedEvent
+	clients map[*feedClient]struct{}
+}
+
+func NewIncidentFeed(client IncidentServiceClient, token auth.TokenSource) *IncidentFeed {
+	return &IncidentFeed{
+		client:  client,
+		token:   token,
+		boot:    strconv.FormatInt(time.Now().UnixMilli(), 36),
+		clients: map[*feedClient]struct{}{},
+	}
+}
+
+// Run receives changes from incident-notifier until ctx is done, reconnecting with a growing delay.
+func (f *IncidentFeed) Run(ctx context.Context) {
+	delay := feedReconnectDelay
+	for {
+		received, err := f.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if received {
+			delay = feedReconnectDelay
+		}
+
+		log.Printf("Przerwano strumień zmian incydentów, ponowna próba za %v, error: %v\n", delay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > feedMaxReconnectWait {
+			delay = feedMaxReconnectWait
+		}
+	}
+}
+
+func (f *IncidentFeed) watch(ctx context.Context) (bool, error) {
+	token, err := f.token()
+	if err != nil {
+		return false, err
+	}
+
+	stream, err := f.client.WatchIncidents(auth.WithToken(ctx, token), &WatchIncidentsRequest{})
+	if err != nil {
+		return false, err
+	}
+
+	received := false
+	for {
+		change, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+		f.publish(change)
+	}
+}
+
+func (f *IncidentFeed) publish(change *IncidentChange) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lastID++
+	event := feedEvent{seq: f.lastID, change: change}
+
+	f.replay = append(f.replay, event)
+	if len(f.replay) > replayBufferSize {
+		f.replay = append([]feedEvent(nil), f.replay[len(f.replay)-replayBufferSize:]...)
+	}
+
+	for client := range f.clients {
+		if !client.filter.matches(change) {
+			continue
+		}
+		select {
+		case client.events <- event:
+		default:
+			log.Println("Klient strumienia SSE nie nadąża z odbiorem zdarzeń, rozłączanie")
+			delete(f.clients, client)
+			close(client.events)
+		}
+	}
+}
+
+func (f *IncidentFeed) eventID(event feedEvent) string {
+	return f.boot + "-" + strconv.FormatUint(event.seq, 10)
+}
+
+// subscribe registers a client and returns the buffered events newer than lastEventID in the same step,
+// so that no event is lost between the replay and the live feed. When the events after lastEventID are not all
+// buffered any more, the whole buffer is returned and incomplete is set.
+func (f *IncidentFeed) subscribe(filter incidentStreamFilter, lastEventID string) (missed []feedEvent, incomplete bool, client *feedClient) {
+	client = &feedClient{
+		filter: filter,
+		events: make(chan feedEvent, streamClientBuffer),
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if lastEventID != "" {
+		// An ID from another process says nothing about this one's events, so everything buffered is sent.
+		var after uint64
+		boot, seq, found := strings.Cut(lastEventID, "-")
+		parsed, err := strconv.ParseUint(seq, 10, 64)
+		if found && err == nil && boot == f.boot && parsed <= f.lastID {
+			after = parsed
+			incomplete = len(f.replay) > 0 && f.replay[0].seq > after+1
+		} else {
+			incomplete = true
+		}
+
+		for _, event := range f.replay {
+			if event.seq > after && filter.matches(event.change) {
+				missed = append(missed, event)
+			}
+		}
+	}
+
+	f.clients[client] = struct{}{}
+	return missed, incomplete, client
+}
+
+func (f *IncidentFeed) unsubscribe(client *feedClient) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.clients[client]; ok {
+		delete(f.clients, client)
+		close(client.events)
+	}
+}
+
+func (filter incidentStreamFilter) matches(change *IncidentChange) bool {
+	if filter.changeType != IncidentChangeType_INCIDENT_CHANGE_TYPE_UNSPECIFIED && filter.changeType != change.Type {
+		return false
+	}
+	if filter.status != IncidentStatus_INCIDENT_STATUS_UNSPECIFIED && filter.status != change.Incident.GetStatus() {
+		return false
+	}
+	if filter.severity != IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED && filter.severity != change.Incident.GetSeverity() {
+		return false
+	}
+	if filter.zone != "" && !strings.EqualFold(filter.zone, change.Incident.GetLocation().GetZone()) {
+		return false
+	}
+	return true
+}
+
+func streamFilterFromQuery(r *http.Request) (incidentStreamFilter, error) {
+	query := r.URL.Query()
+	filter := incidentStreamFilter{zone: strings.TrimSpace(query.Get("zone"))}
+
+	if value := query.Get("type"); value != "" {
+		number, ok := enumFromName(value, incidentChangeTypePrefix, IncidentChangeType_value)
+		if !ok {
+			return filter, fmt.Errorf("Niepoprawny typ zdarzenia: %s", value)
+		}
+		filter.changeType = IncidentChangeType(number)
+	}
+	if value := query.Get("status"); value != "" {
+		number, ok := enumFromName(value, incidentStatusPrefix, IncidentStatus_value)
+		if !ok {
+			return filter, fmt.Errorf("Niepoprawny status incydentu: %s", value)
+		}
+		filter.status = IncidentStatus(number)
+	}
+	if value := query.Get("severity"); value != "" {
+		number, ok := enumFromName(value, incidentSeverityPrefix, IncidentSeverity_value)
+		if !ok {
+			return filter, fmt.Errorf("Niepoprawny stopień zagrożenia: %s", value)
+		}
+		filter.severity = IncidentSeverity(number)
+	}
+	return filter, nil
+}
+
+func writeStreamEvent(w http.ResponseWriter, id string, event feedEvent) error {
+	data, err := protojson.Marshal(event.change)
+	if err != nil {
+		return err
+	}
+
+	eventName := strings.ToLower(strings.TrimPrefix(event.change.Type.String(), incidentChangeTypePrefix))
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", id, eventName, data)
+	return err
+}
+
+// EventSource cannot set headers on its first request, so Last-Event-ID is also accepted as a query parameter.
+func IncidentStreamHandler(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSONError(w, r, http.StatusInternalServerError, "Serwer nie obsługuje strumieniowania odpowiedzi")
+		return
+	}
+
+	if !requirePermission(w, r, "/main.IncidentService/WatchIncidents", auth.StaffRoles) {
+		return
+	}
+
+	filter, err := streamFilterFromQuery(r)
+	if err != nil {
+		writeJSONError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+
+	missed, incomplete, client := incidentFeed.subscribe(filter, lastEventID)
+	defer incidentFeed.unsubscribe(client)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	log.Printf("Nawiązano połączenie SSE, filtr: %+v, wznowienie po zdarzeniu: %q\n", filter, lastEventID)
+
+	// The client should reload the incidents, the replayed events alone may not bring it up to date.
+	if incomplete {
+		if _, err := fmt.Fprint(w, "event: reset\ndata: {}\n\n"); err != nil {
+			return
+		}
+	}
+	for _, event := range missed {
+		if err := writeStreamEvent(w, incidentFeed.eventID(event), event); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			log.Println("Zamknięto połączenie SSE")
+			return
+
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+
+		case event, ok := <-client.events:
+			if !ok {
+				return
+			}
+			if err := writeStreamEvent(w, incidentFeed.eventID(event), event); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
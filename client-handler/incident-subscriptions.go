@@ -0,0 +1,89 @@
+package main
+
+import (
+	"io"
+	"log"
+
+	"github.com/graphql-go/graphql"
+)
+
+var watchFilterArgs = graphql.FieldConfigArgument{
+	"status": &graphql.ArgumentConfig{
+		Type: incidentStatusEnum,
+	},
+	"severity": &graphql.ArgumentConfig{
+		Type: incidentSeverityEnum,
+	},
+	"zone": &graphql.ArgumentConfig{
+		Type: graphql.String,
+	},
+}
+
+// Each subscription opens its own WatchIncidents stream, closed together with the subscription context.
+func watchIncidents(changeType IncidentChangeType) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		status, _ := p.Args["status"].(IncidentStatus)
+		severity, _ := p.Args["severity"].(IncidentSeverity)
+		zone, _ := p.Args["zone"].(string)
+
+		stream, err := incidentClient.WatchIncidents(p.Context, &WatchIncidentsRequest{
+			Status:   status,
+			Severity: severity,
+			Zone:     zone,
+		})
+		if err != nil {
+			log.Printf("Nie udało się rozpocząć obserwowania incydentów, error: %v\n", err)
+			return nil, graphqlError(err)
+		}
+
+		incidents := make(chan interface{})
+		go func() {
+			defer close(incidents)
+			for {
+				change, err := stream.Recv()
+				if err != nil {
+					if err != io.EOF && p.Context.Err() == nil {
+						log.Printf("Przerwano obserwowanie incydentów, error: %v\n", err)
+					}
+					return
+				}
+				if change.Type != changeType {
+					continue
+				}
+
+				select {
+				case incidents <- change.Incident:
+				case <-p.Context.Done():
+					return
+				}
+			}
+		}()
+
+		return incidents, nil
+	}
+}
+
+// The subscription payload is the changed incident itself.
+func resolveChangedIncident(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source, nil
+}
+
+var rootSubscription = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"incidentCreated": &graphql.Field{
+				Type:      incidentType,
+				Args:      watchFilterArgs,
+				Subscribe: watchIncidents(IncidentChangeType_INCIDENT_CHANGE_TYPE_CREATED),
+				Resolve:   resolveChangedIncident,
+			},
+			"incidentUpdated": &graphql.Field{
+				Type:      incidentType,
+				Args:      watchFilterArgs,
+				Subscribe: watchIncidents(IncidentChangeType_INCIDENT_CHANGE_TYPE_UPDATED),
+				Resolve:   resolveChangedIncident,
+			},
+		},
+	},
+)
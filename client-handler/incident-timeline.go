@@ -0,0 +1,124 @@
+package main
+
+import (
+	"log"
+
+	"github.com/graphql-go/graphql"
+)
+
+const timelinePageSize = 100
+
+var timelineEntryTypeEnum = graphql.NewEnum(
+	graphql.EnumConfig{
+		Name: "TimelineEntryType",
+		Values: graphql.EnumValueConfigMap{
+			"STATUS_CHANGE": &graphql.EnumValueConfig{
+				Value: TimelineEntryType_TIMELINE_ENTRY_TYPE_STATUS_CHANGE,
+			},
+			"NOTE": &graphql.EnumValueConfig{
+				Value:       TimelineEntryType_TIMELINE_ENTRY_TYPE_NOTE,
+				Description: "Notatka dodana przez dyspozytora",
+			},
+			"ASSIGNMENT": &graphql.EnumValueConfig{
+				Value: TimelineEntryType_TIMELINE_ENTRY_TYPE_ASSIGNMENT,
+			},
+			"SYSTEM": &graphql.EnumValueConfig{
+				Value: TimelineEntryType_TIMELINE_ENTRY_TYPE_SYSTEM,
+			},
+		},
+	},
+)
+
+var timelineEntryType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "TimelineEntry",
+		Fields: graphql.Fields{
+			"entryID": &graphql.Field{
+				Type: graphql.String,
+			},
+			"incidentID": &graphql.Field{
+				Type: graphql.String,
+			},
+			"type": &graphql.Field{
+				Type: timelineEntryTypeEnum,
+			},
+			"createdAt": &graphql.Field{
+				Type: graphql.String,
+			},
+			"author": &graphql.Field{
+				Type: graphql.String,
+			},
+			"text": &graphql.Field{
+				Type: graphql.String,
+			},
+			"fromStatus": &graphql.Field{
+				Type: incidentStatusEnum,
+			},
+			"toStatus": &graphql.Field{
+				Type: incidentStatusEnum,
+			},
+			"lifeguardIDs": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+			},
+			"vehicleIDs": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+			},
+		},
+	},
+)
+
+// Resolves the whole timeline of the parent incident, oldest entries first.
+func resolveIncidentTimeline(p graphql.ResolveParams) (interface{}, error) {
+	incident, ok := p.Source.(*IncidentProto)
+	if !ok {
+		return nil, nil
+	}
+
+	var entries []*TimelineEntry
+	pageToken := ""
+	for {
+		resp, err := incidentClient.GetIncidentTimeline(p.Context, &GetIncidentTimelineRequest{
+			IncidentID: incident.IncidentID,
+			PageSize:   timelinePageSize,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			log.Printf("Nie udało się pobrać osi czasu incydentu o ID: %s, error: %v\n", incident.IncidentID, err)
+			return nil, graphqlError(err)
+		}
+
+		entries = append(entries, resp.Entries...)
+		if resp.NextPageToken == "" {
+			return entries, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+var addIncidentNoteField = &graphql.Field{
+	Type: timelineEntryType,
+	Args: graphql.FieldConfigArgument{
+		"incidentID": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"text": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		incidentID := p.Args["incidentID"].(string)
+		text := p.Args["text"].(string)
+
+		resp, err := incidentClient.AddIncidentNote(p.Context, &AddIncidentNoteRequest{
+			IncidentID: incidentID,
+			Text:       text,
+		})
+		if err != nil {
+			log.Printf("Nie udało się dodać notatki do incydentu o ID: %s, error: %v\n", incidentID, err)
+			return nil, graphqlError(err)
+		}
+
+		log.Printf("Dodano notatkę do incydentu: %+v\n", resp.Entry)
+		return resp.Entry, nil
+	},
+}
@@ -1,12 +1,14 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.14.0
 // source: incident.proto
 
 package main
 
 import (
+	_ "github.com/szbobrowski/master-thesis/validation"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,20 +22,313 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type IncidentProto struct {
+// Incident lifecycle. Allowed transitions are enforced by UpdateIncident:
+// REPORTED -> ACKNOWLEDGED | DISPATCHED | CANCELLED
+// ACKNOWLEDGED -> DISPATCHED | CANCELLED
+// DISPATCHED -> ON_SCENE | CANCELLED
+// ON_SCENE -> RESOLVED
+// RESOLVED -> CLOSED
+// CLOSED and CANCELLED are final.
+type IncidentStatus int32
+
+const (
+	IncidentStatus_INCIDENT_STATUS_UNSPECIFIED  IncidentStatus = 0
+	IncidentStatus_INCIDENT_STATUS_REPORTED     IncidentStatus = 1
+	IncidentStatus_INCIDENT_STATUS_RESOLVED     IncidentStatus = 3
+	IncidentStatus_INCIDENT_STATUS_CLOSED       IncidentStatus = 4
+	IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED IncidentStatus = 5
+	IncidentStatus_INCIDENT_STATUS_DISPATCHED   IncidentStatus = 6
+	IncidentStatus_INCIDENT_STATUS_ON_SCENE     IncidentStatus = 7
+	IncidentStatus_INCIDENT_STATUS_CANCELLED    IncidentStatus = 8
+)
+
+// Enum value maps for IncidentStatus.
+var (
+	IncidentStatus_name = map[int32]string{
+		0: "INCIDENT_STATUS_UNSPECIFIED",
+		1: "INCIDENT_STATUS_REPORTED",
+		3: "INCIDENT_STATUS_RESOLVED",
+		4: "INCIDENT_STATUS_CLOSED",
+		5: "INCIDENT_STATUS_ACKNOWLEDGED",
+		6: "INCIDENT_STATUS_DISPATCHED",
+		7: "INCIDENT_STATUS_ON_SCENE",
+		8: "INCIDENT_STATUS_CANCELLED",
+	}
+	IncidentStatus_value = map[string]int32{
+		"INCIDENT_STATUS_UNSPECIFIED":  0,
+		"INCIDENT_STATUS_REPORTED":     1,
+		"INCIDENT_STATUS_RESOLVED":     3,
+		"INCIDENT_STATUS_CLOSED":       4,
+		"INCIDENT_STATUS_ACKNOWLEDGED": 5,
+		"INCIDENT_STATUS_DISPATCHED":   6,
+		"INCIDENT_STATUS_ON_SCENE":     7,
+		"INCIDENT_STATUS_CANCELLED":    8,
+	}
+)
+
+func (x IncidentStatus) Enum() *IncidentStatus {
+	p := new(IncidentStatus)
+	*p = x
+	return p
+}
+
+func (x IncidentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncidentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_incident_proto_enumTypes[0].Descriptor()
+}
+
+func (IncidentStatus) Type() protoreflect.EnumType {
+	return &file_incident_proto_enumTypes[0]
+}
+
+func (x IncidentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncidentStatus.Descriptor instead.
+func (IncidentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{0}
+}
+
+type IncidentSeverity int32
+
+const (
+	IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED IncidentSeverity = 0
+	IncidentSeverity_INCIDENT_SEVERITY_LOW         IncidentSeverity = 1
+	IncidentSeverity_INCIDENT_SEVERITY_MODERATE    IncidentSeverity = 2
+	IncidentSeverity_INCIDENT_SEVERITY_HIGH        IncidentSeverity = 3
+	// Life-threatening.
+	IncidentSeverity_INCIDENT_SEVERITY_CRITICAL IncidentSeverity = 4
+)
+
+// Enum value maps for IncidentSeverity.
+var (
+	IncidentSeverity_name = map[int32]string{
+		0: "INCIDENT_SEVERITY_UNSPECIFIED",
+		1: "INCIDENT_SEVERITY_LOW",
+		2: "INCIDENT_SEVERITY_MODERATE",
+		3: "INCIDENT_SEVERITY_HIGH",
+		4: "INCIDENT_SEVERITY_CRITICAL",
+	}
+	IncidentSeverity_value = map[string]int32{
+		"INCIDENT_SEVERITY_UNSPECIFIED": 0,
+		"INCIDENT_SEVERITY_LOW":         1,
+		"INCIDENT_SEVERITY_MODERATE":    2,
+		"INCIDENT_SEVERITY_HIGH":        3,
+		"INCIDENT_SEVERITY_CRITICAL":    4,
+	}
+)
+
+func (x IncidentSeverity) Enum() *IncidentSeverity {
+	p := new(IncidentSeverity)
+	*p = x
+	return p
+}
+
+func (x IncidentSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncidentSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_incident_proto_enumTypes[1].Descriptor()
+}
+
+func (IncidentSeverity) Type() protoreflect.EnumType {
+	return &file_incident_proto_enumTypes[1]
+}
+
+func (x IncidentSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncidentSeverity.Descriptor instead.
+func (IncidentSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{1}
+}
+
+type IncidentCategory int32
+
+const (
+	IncidentCategory_INCIDENT_CATEGORY_UNSPECIFIED IncidentCategory = 0
+	IncidentCategory_INCIDENT_CATEGORY_DROWNING    IncidentCategory = 1
+	IncidentCategory_INCIDENT_CATEGORY_INJURY      IncidentCategory = 2
+	IncidentCategory_INCIDENT_CATEGORY_LOST_PERSON IncidentCategory = 3
+	IncidentCategory_INCIDENT_CATEGORY_HAZARD      IncidentCategory = 4
+	IncidentCategory_INCIDENT_CATEGORY_OTHER       IncidentCategory = 5
+)
+
+// Enum value maps for IncidentCategory.
+var (
+	IncidentCategory_name = map[int32]string{
+		0: "INCIDENT_CATEGORY_UNSPECIFIED",
+		1: "INCIDENT_CATEGORY_DROWNING",
+		2: "INCIDENT_CATEGORY_INJURY",
+		3: "INCIDENT_CATEGORY_LOST_PERSON",
+		4: "INCIDENT_CATEGORY_HAZARD",
+		5: "INCIDENT_CATEGORY_OTHER",
+	}
+	IncidentCategory_value = map[string]int32{
+		"INCIDENT_CATEGORY_UNSPECIFIED": 0,
+		"INCIDENT_CATEGORY_DROWNING":    1,
+		"INCIDENT_CATEGORY_INJURY":      2,
+		"INCIDENT_CATEGORY_LOST_PERSON": 3,
+		"INCIDENT_CATEGORY_HAZARD":      4,
+		"INCIDENT_CATEGORY_OTHER":       5,
+	}
+)
+
+func (x IncidentCategory) Enum() *IncidentCategory {
+	p := new(IncidentCategory)
+	*p = x
+	return p
+}
+
+func (x IncidentCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncidentCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_incident_proto_enumTypes[2].Descriptor()
+}
+
+func (IncidentCategory) Type() protoreflect.EnumType {
+	return &file_incident_proto_enumTypes[2]
+}
+
+func (x IncidentCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncidentCategory.Descriptor instead.
+func (IncidentCategory) EnumDescriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{2}
+}
+
+type TimelineEntryType int32
+
+const (
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_UNSPECIFIED   TimelineEntryType = 0
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_STATUS_CHANGE TimelineEntryType = 1
+	// Free-text note added by a dispatcher.
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_NOTE       TimelineEntryType = 2
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_ASSIGNMENT TimelineEntryType = 3
+	// Recorded by the service itself, such as the incident being reported or deleted.
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_SYSTEM TimelineEntryType = 4
+)
+
+// Enum value maps for TimelineEntryType.
+var (
+	TimelineEntryType_name = map[int32]string{
+		0: "TIMELINE_ENTRY_TYPE_UNSPECIFIED",
+		1: "TIMELINE_ENTRY_TYPE_STATUS_CHANGE",
+		2: "TIMELINE_ENTRY_TYPE_NOTE",
+		3: "TIMELINE_ENTRY_TYPE_ASSIGNMENT",
+		4: "TIMELINE_ENTRY_TYPE_SYSTEM",
+	}
+	TimelineEntryType_value = map[string]int32{
+		"TIMELINE_ENTRY_TYPE_UNSPECIFIED":   0,
+		"TIMELINE_ENTRY_TYPE_STATUS_CHANGE": 1,
+		"TIMELINE_ENTRY_TYPE_NOTE":          2,
+		"TIMELINE_ENTRY_TYPE_ASSIGNMENT":    3,
+		"TIMELINE_ENTRY_TYPE_SYSTEM":        4,
+	}
+)
+
+func (x TimelineEntryType) Enum() *TimelineEntryType {
+	p := new(TimelineEntryType)
+	*p = x
+	return p
+}
+
+func (x TimelineEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_incident_proto_enumTypes[3].Descriptor()
+}
+
+func (TimelineEntryType) Type() protoreflect.EnumType {
+	return &file_incident_proto_enumTypes[3]
+}
+
+func (x TimelineEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineEntryType.Descriptor instead.
+func (TimelineEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{3}
+}
+
+type IncidentChangeType int32
+
+const (
+	IncidentChangeType_INCIDENT_CHANGE_TYPE_UNSPECIFIED IncidentChangeType = 0
+	IncidentChangeType_INCIDENT_CHANGE_TYPE_CREATED     IncidentChangeType = 1
+	IncidentChangeType_INCIDENT_CHANGE_TYPE_UPDATED     IncidentChangeType = 2
+	IncidentChangeType_INCIDENT_CHANGE_TYPE_DELETED     IncidentChangeType = 3
+)
+
+// Enum value maps for IncidentChangeType.
+var (
+	IncidentChangeType_name = map[int32]string{
+		0: "INCIDENT_CHANGE_TYPE_UNSPECIFIED",
+		1: "INCIDENT_CHANGE_TYPE_CREATED",
+		2: "INCIDENT_CHANGE_TYPE_UPDATED",
+		3: "INCIDENT_CHANGE_TYPE_DELETED",
+	}
+	IncidentChangeType_value = map[string]int32{
+		"INCIDENT_CHANGE_TYPE_UNSPECIFIED": 0,
+		"INCIDENT_CHANGE_TYPE_CREATED":     1,
+		"INCIDENT_CHANGE_TYPE_UPDATED":     2,
+		"INCIDENT_CHANGE_TYPE_DELETED":     3,
+	}
+)
+
+func (x IncidentChangeType) Enum() *IncidentChangeType {
+	p := new(IncidentChangeType)
+	*p = x
+	return p
+}
+
+func (x IncidentChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncidentChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_incident_proto_enumTypes[4].Descriptor()
+}
+
+func (IncidentChangeType) Type() protoreflect.EnumType {
+	return &file_incident_proto_enumTypes[4]
+}
+
+func (x IncidentChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncidentChangeType.Descriptor instead.
+func (IncidentChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{4}
+}
+
+type Location struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IncidentID   string `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
-	Title        string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description  string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status       string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	CreationDate string `protobuf:"bytes,5,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Beach sector or pool zone, such as "Plaża Główna, sektor B".
+	Zone string `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
 }
 
-func (x *IncidentProto) Reset() {
-	*x = IncidentProto{}
+func (x *Location) Reset() {
+	*x = Location{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_incident_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -41,13 +336,13 @@ func (x *IncidentProto) Reset() {
 	}
 }
 
-func (x *IncidentProto) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncidentProto) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *IncidentProto) ProtoReflect() protoreflect.Message {
+func (x *Location) ProtoReflect() protoreflect.Message {
 	mi := &file_incident_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -59,74 +354,148 @@ func (x *IncidentProto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncidentProto.ProtoReflect.Descriptor instead.
-func (*IncidentProto) Descriptor() ([]byte, []int) {
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
 	return file_incident_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *IncidentProto) GetIncidentID() string {
+func (x *Location) GetLatitude() float64 {
 	if x != nil {
-		return x.IncidentID
+		return x.Latitude
 	}
-	return ""
+	return 0
 }
 
-func (x *IncidentProto) GetTitle() string {
+func (x *Location) GetLongitude() float64 {
 	if x != nil {
-		return x.Title
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetZone() string {
+	if x != nil {
+		return x.Zone
 	}
 	return ""
 }
 
-func (x *IncidentProto) GetDescription() string {
+type ReporterContact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *ReporterContact) Reset() {
+	*x = ReporterContact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReporterContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReporterContact) ProtoMessage() {}
+
+func (x *ReporterContact) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReporterContact.ProtoReflect.Descriptor instead.
+func (*ReporterContact) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReporterContact) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *IncidentProto) GetStatus() string {
+func (x *ReporterContact) GetPhone() string {
 	if x != nil {
-		return x.Status
+		return x.Phone
 	}
 	return ""
 }
 
-func (x *IncidentProto) GetCreationDate() string {
+func (x *ReporterContact) GetEmail() string {
 	if x != nil {
-		return x.CreationDate
+		return x.Email
 	}
 	return ""
 }
 
-type CreateIncidentRequest struct {
+type IncidentProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title        string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description  string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Status       string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	CreationDate string `protobuf:"bytes,4,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	IncidentID  string `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The status exactly as stored, kept for older clients and for values that cannot be normalized.
+	//
+	// Deprecated: Marked as deprecated in incident.proto.
+	LegacyStatus       string         `protobuf:"bytes,4,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"`
+	CreationDate       string         `protobuf:"bytes,5,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	Status             IncidentStatus `protobuf:"varint,6,opt,name=status,proto3,enum=main.IncidentStatus" json:"status,omitempty"`
+	StatusChangedAt    string         `protobuf:"bytes,7,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	AcknowledgedAt     string         `protobuf:"bytes,8,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	DispatchedAt       string         `protobuf:"bytes,9,opt,name=dispatched_at,json=dispatchedAt,proto3" json:"dispatched_at,omitempty"`
+	OnSceneAt          string         `protobuf:"bytes,10,opt,name=on_scene_at,json=onSceneAt,proto3" json:"on_scene_at,omitempty"`
+	ResolvedAt         string         `protobuf:"bytes,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ClosedAt           string         `protobuf:"bytes,12,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	CancelledAt        string         `protobuf:"bytes,13,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	ResolutionNote     string         `protobuf:"bytes,14,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	CancellationReason string         `protobuf:"bytes,15,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	// Short, human-friendly code for radio use, such as INC-7K3Q. Not guaranteed to be unique.
+	ShortCode string           `protobuf:"bytes,16,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	Severity  IncidentSeverity `protobuf:"varint,17,opt,name=severity,proto3,enum=main.IncidentSeverity" json:"severity,omitempty"`
+	// 1 is the most urgent.
+	Priority             int32            `protobuf:"varint,18,opt,name=priority,proto3" json:"priority,omitempty"`
+	Category             IncidentCategory `protobuf:"varint,19,opt,name=category,proto3,enum=main.IncidentCategory" json:"category,omitempty"`
+	Location             *Location        `protobuf:"bytes,20,opt,name=location,proto3" json:"location,omitempty"`
+	Reporter             *ReporterContact `protobuf:"bytes,21,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	PeopleInvolved       int32            `protobuf:"varint,22,opt,name=people_involved,json=peopleInvolved,proto3" json:"people_involved,omitempty"`
+	AssignedLifeguardIds []int64          `protobuf:"varint,23,rep,packed,name=assigned_lifeguard_ids,json=assignedLifeguardIds,proto3" json:"assigned_lifeguard_ids,omitempty"`
+	AssignedVehicleIds   []int64          `protobuf:"varint,24,rep,packed,name=assigned_vehicle_ids,json=assignedVehicleIds,proto3" json:"assigned_vehicle_ids,omitempty"`
 }
 
-func (x *CreateIncidentRequest) Reset() {
-	*x = CreateIncidentRequest{}
+func (x *IncidentProto) Reset() {
+	*x = IncidentProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_incident_proto_msgTypes[1]
+		mi := &file_incident_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateIncidentRequest) String() string {
+func (x *IncidentProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIncidentRequest) ProtoMessage() {}
+func (*IncidentProto) ProtoMessage() {}
 
-func (x *CreateIncidentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_incident_proto_msgTypes[1]
+func (x *IncidentProto) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,64 +506,1120 @@ func (x *CreateIncidentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIncidentRequest.ProtoReflect.Descriptor instead.
-func (*CreateIncidentRequest) Descriptor() ([]byte, []int) {
-	return file_incident_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use IncidentProto.ProtoReflect.Descriptor instead.
+func (*IncidentProto) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateIncidentRequest) GetTitle() string {
+func (x *IncidentProto) GetIncidentID() string {
+	if x != nil {
+		return x.IncidentID
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
-	return ""
+	return ""
+}
+
+func (x *IncidentProto) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in incident.proto.
+func (x *IncidentProto) GetLegacyStatus() string {
+	if x != nil {
+		return x.LegacyStatus
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetCreationDate() string {
+	if x != nil {
+		return x.CreationDate
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *IncidentProto) GetStatusChangedAt() string {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetAcknowledgedAt() string {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetDispatchedAt() string {
+	if x != nil {
+		return x.DispatchedAt
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetOnSceneAt() string {
+	if x != nil {
+		return x.OnSceneAt
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetCancelledAt() string {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *IncidentProto) GetSeverity() IncidentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *IncidentProto) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *IncidentProto) GetCategory() IncidentCategory {
+	if x != nil {
+		return x.Category
+	}
+	return IncidentCategory_INCIDENT_CATEGORY_UNSPECIFIED
+}
+
+func (x *IncidentProto) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *IncidentProto) GetReporter() *ReporterContact {
+	if x != nil {
+		return x.Reporter
+	}
+	return nil
+}
+
+func (x *IncidentProto) GetPeopleInvolved() int32 {
+	if x != nil {
+		return x.PeopleInvolved
+	}
+	return 0
+}
+
+func (x *IncidentProto) GetAssignedLifeguardIds() []int64 {
+	if x != nil {
+		return x.AssignedLifeguardIds
+	}
+	return nil
+}
+
+func (x *IncidentProto) GetAssignedVehicleIds() []int64 {
+	if x != nil {
+		return x.AssignedVehicleIds
+	}
+	return nil
+}
+
+type CreateIncidentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Free-text status accepted from older clients, used only when status is unspecified.
+	//
+	// Deprecated: Marked as deprecated in incident.proto.
+	LegacyStatus string `protobuf:"bytes,3,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"`
+	CreationDate string `protobuf:"bytes,4,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// Optional, a new incident always starts as REPORTED.
+	Status IncidentStatus `protobuf:"varint,5,opt,name=status,proto3,enum=main.IncidentStatus" json:"status,omitempty"`
+	// Retried requests with the same key return the incident created by the first one.
+	IdempotencyKey string           `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Severity       IncidentSeverity `protobuf:"varint,7,opt,name=severity,proto3,enum=main.IncidentSeverity" json:"severity,omitempty"`
+	// Derived from the severity when not set.
+	Priority             int32            `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	Category             IncidentCategory `protobuf:"varint,9,opt,name=category,proto3,enum=main.IncidentCategory" json:"category,omitempty"`
+	Location             *Location        `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	Reporter             *ReporterContact `protobuf:"bytes,11,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	PeopleInvolved       int32            `protobuf:"varint,12,opt,name=people_involved,json=peopleInvolved,proto3" json:"people_involved,omitempty"`
+	AssignedLifeguardIds []int64          `protobuf:"varint,13,rep,packed,name=assigned_lifeguard_ids,json=assignedLifeguardIds,proto3" json:"assigned_lifeguard_ids,omitempty"`
+	AssignedVehicleIds   []int64          `protobuf:"varint,14,rep,packed,name=assigned_vehicle_ids,json=assignedVehicleIds,proto3" json:"assigned_vehicle_ids,omitempty"`
+}
+
+func (x *CreateIncidentRequest) Reset() {
+	*x = CreateIncidentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIncidentRequest) ProtoMessage() {}
+
+func (x *CreateIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIncidentRequest.ProtoReflect.Descriptor instead.
+func (*CreateIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateIncidentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateIncidentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in incident.proto.
+func (x *CreateIncidentRequest) GetLegacyStatus() string {
+	if x != nil {
+		return x.LegacyStatus
+	}
+	return ""
+}
+
+func (x *CreateIncidentRequest) GetCreationDate() string {
+	if x != nil {
+		return x.CreationDate
+	}
+	return ""
+}
+
+func (x *CreateIncidentRequest) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *CreateIncidentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateIncidentRequest) GetSeverity() IncidentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *CreateIncidentRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateIncidentRequest) GetCategory() IncidentCategory {
+	if x != nil {
+		return x.Category
+	}
+	return IncidentCategory_INCIDENT_CATEGORY_UNSPECIFIED
+}
+
+func (x *CreateIncidentRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateIncidentRequest) GetReporter() *ReporterContact {
+	if x != nil {
+		return x.Reporter
+	}
+	return nil
+}
+
+func (x *CreateIncidentRequest) GetPeopleInvolved() int32 {
+	if x != nil {
+		return x.PeopleInvolved
+	}
+	return 0
+}
+
+func (x *CreateIncidentRequest) GetAssignedLifeguardIds() []int64 {
+	if x != nil {
+		return x.AssignedLifeguardIds
+	}
+	return nil
+}
+
+func (x *CreateIncidentRequest) GetAssignedVehicleIds() []int64 {
+	if x != nil {
+		return x.AssignedVehicleIds
+	}
+	return nil
+}
+
+type GetIncidentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncidentID string `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+}
+
+func (x *GetIncidentRequest) Reset() {
+	*x = GetIncidentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncidentRequest) ProtoMessage() {}
+
+func (x *GetIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncidentRequest.ProtoReflect.Descriptor instead.
+func (*GetIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetIncidentRequest) GetIncidentID() string {
+	if x != nil {
+		return x.IncidentID
+	}
+	return ""
+}
+
+type UpdateIncidentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncidentID string `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	// Free-text status accepted from older clients, used only when status is unspecified.
+	//
+	// Deprecated: Marked as deprecated in incident.proto.
+	LegacyStatus string `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"`
+	// Optional when only the assignments change.
+	Status IncidentStatus `protobuf:"varint,3,opt,name=status,proto3,enum=main.IncidentStatus" json:"status,omitempty"`
+	// Required when the status changes to RESOLVED.
+	ResolutionNote string `protobuf:"bytes,4,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	// Required when the status changes to CANCELLED.
+	CancellationReason string `protobuf:"bytes,5,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	// Replaces the assigned lifeguards when set, an empty list removes them all.
+	AssignedLifeguards *AssignedResources `protobuf:"bytes,6,opt,name=assigned_lifeguards,json=assignedLifeguards,proto3" json:"assigned_lifeguards,omitempty"`
+	// Replaces the assigned vehicles when set, an empty list removes them all.
+	AssignedVehicles *AssignedResources `protobuf:"bytes,7,opt,name=assigned_vehicles,json=assignedVehicles,proto3" json:"assigned_vehicles,omitempty"`
+}
+
+func (x *UpdateIncidentRequest) Reset() {
+	*x = UpdateIncidentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIncidentRequest) ProtoMessage() {}
+
+func (x *UpdateIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIncidentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateIncidentRequest) GetIncidentID() string {
+	if x != nil {
+		return x.IncidentID
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in incident.proto.
+func (x *UpdateIncidentRequest) GetLegacyStatus() string {
+	if x != nil {
+		return x.LegacyStatus
+	}
+	return ""
+}
+
+func (x *UpdateIncidentRequest) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateIncidentRequest) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *UpdateIncidentRequest) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
+}
+
+func (x *UpdateIncidentRequest) GetAssignedLifeguards() *AssignedResources {
+	if x != nil {
+		return x.AssignedLifeguards
+	}
+	return nil
+}
+
+func (x *UpdateIncidentRequest) GetAssignedVehicles() *AssignedResources {
+	if x != nil {
+		return x.AssignedVehicles
+	}
+	return nil
+}
+
+type AssignedResources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *AssignedResources) Reset() {
+	*x = AssignedResources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignedResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignedResources) ProtoMessage() {}
+
+func (x *AssignedResources) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignedResources.ProtoReflect.Descriptor instead.
+func (*AssignedResources) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AssignedResources) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type DeleteIncidentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncidentID string `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+}
+
+func (x *DeleteIncidentRequest) Reset() {
+	*x = DeleteIncidentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIncidentRequest) ProtoMessage() {}
+
+func (x *DeleteIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIncidentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteIncidentRequest) GetIncidentID() string {
+	if x != nil {
+		return x.IncidentID
+	}
+	return ""
+}
+
+// Incidents are returned newest first. Every filter is optional.
+type ListIncidentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      IncidentStatus `protobuf:"varint,1,opt,name=status,proto3,enum=main.IncidentStatus" json:"status,omitempty"`
+	CreatedFrom string         `protobuf:"bytes,2,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   string         `protobuf:"bytes,3,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// Defaults to 20.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token or one of the cursors of a previous response with the same filters.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListIncidentsRequest) Reset() {
+	*x = ListIncidentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIncidentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsRequest) ProtoMessage() {}
+
+func (x *ListIncidentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsRequest.ProtoReflect.Descriptor instead.
+func (*ListIncidentsRequest) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListIncidentsRequest) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *ListIncidentsRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListIncidentsRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListIncidentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIncidentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListIncidentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Incidents []*IncidentProto `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	// Cursor of each returned incident, in the same order.
+	Cursors []string `protobuf:"bytes,2,rep,name=cursors,proto3" json:"cursors,omitempty"`
+	// Empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListIncidentsResponse) Reset() {
+	*x = ListIncidentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIncidentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsResponse) ProtoMessage() {}
+
+func (x *ListIncidentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsResponse.ProtoReflect.Descriptor instead.
+func (*ListIncidentsResponse) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListIncidentsResponse) GetIncidents() []*IncidentProto {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+func (x *ListIncidentsResponse) GetCursors() []string {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+func (x *ListIncidentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Incidents are returned in no particular order. Unknown IDs are left out.
+type BatchGetIncidentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncidentIds []string `protobuf:"bytes,1,rep,name=incident_ids,json=incidentIds,proto3" json:"incident_ids,omitempty"`
+}
+
+func (x *BatchGetIncidentsRequest) Reset() {
+	*x = BatchGetIncidentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetIncidentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetIncidentsRequest) ProtoMessage() {}
+
+func (x *BatchGetIncidentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetIncidentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetIncidentsRequest) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchGetIncidentsRequest) GetIncidentIds() []string {
+	if x != nil {
+		return x.IncidentIds
+	}
+	return nil
+}
+
+type BatchGetIncidentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Incidents []*IncidentProto `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
+}
+
+func (x *BatchGetIncidentsResponse) Reset() {
+	*x = BatchGetIncidentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetIncidentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetIncidentsResponse) ProtoMessage() {}
+
+func (x *BatchGetIncidentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetIncidentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetIncidentsResponse) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchGetIncidentsResponse) GetIncidents() []*IncidentProto {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+type IncidentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Incident *IncidentProto `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`
+}
+
+func (x *IncidentResponse) Reset() {
+	*x = IncidentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentResponse) ProtoMessage() {}
+
+func (x *IncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentResponse.ProtoReflect.Descriptor instead.
+func (*IncidentResponse) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *IncidentResponse) GetIncident() *IncidentProto {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+type DeleteIncidentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteIncidentResponse) Reset() {
+	*x = DeleteIncidentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIncidentResponse) ProtoMessage() {}
+
+func (x *DeleteIncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIncidentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIncidentResponse) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteIncidentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type TimelineEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryId    string            `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	IncidentID string            `protobuf:"bytes,2,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Type       TimelineEntryType `protobuf:"varint,3,opt,name=type,proto3,enum=main.TimelineEntryType" json:"type,omitempty"`
+	CreatedAt  string            `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Author     string            `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Text       string            `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	// Set for status changes.
+	FromStatus IncidentStatus `protobuf:"varint,7,opt,name=from_status,json=fromStatus,proto3,enum=main.IncidentStatus" json:"from_status,omitempty"`
+	ToStatus   IncidentStatus `protobuf:"varint,8,opt,name=to_status,json=toStatus,proto3,enum=main.IncidentStatus" json:"to_status,omitempty"`
+	// Set for assignments.
+	LifeguardIds []int64 `protobuf:"varint,9,rep,packed,name=lifeguard_ids,json=lifeguardIds,proto3" json:"lifeguard_ids,omitempty"`
+	VehicleIds   []int64 `protobuf:"varint,10,rep,packed,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+}
+
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimelineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEntry) ProtoMessage() {}
+
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TimelineEntry) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetIncidentID() string {
+	if x != nil {
+		return x.IncidentID
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetType() TimelineEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return TimelineEntryType_TIMELINE_ENTRY_TYPE_UNSPECIFIED
+}
+
+func (x *TimelineEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetFromStatus() IncidentStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *TimelineEntry) GetToStatus() IncidentStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *TimelineEntry) GetLifeguardIds() []int64 {
+	if x != nil {
+		return x.LifeguardIds
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetVehicleIds() []int64 {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+type AddIncidentNoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncidentID string `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Text       string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *AddIncidentNoteRequest) Reset() {
+	*x = AddIncidentNoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_incident_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddIncidentNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddIncidentNoteRequest) ProtoMessage() {}
+
+func (x *AddIncidentNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *CreateIncidentRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use AddIncidentNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddIncidentNoteRequest) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateIncidentRequest) GetStatus() string {
+func (x *AddIncidentNoteRequest) GetIncidentID() string {
 	if x != nil {
-		return x.Status
+		return x.IncidentID
 	}
 	return ""
 }
 
-func (x *CreateIncidentRequest) GetCreationDate() string {
+func (x *AddIncidentNoteRequest) GetText() string {
 	if x != nil {
-		return x.CreationDate
+		return x.Text
 	}
 	return ""
 }
 
-type GetIncidentRequest struct {
+type TimelineEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IncidentID string `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Entry *TimelineEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *GetIncidentRequest) Reset() {
-	*x = GetIncidentRequest{}
+func (x *TimelineEntryResponse) Reset() {
+	*x = TimelineEntryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_incident_proto_msgTypes[2]
+		mi := &file_incident_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetIncidentRequest) String() string {
+func (x *TimelineEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIncidentRequest) ProtoMessage() {}
+func (*TimelineEntryResponse) ProtoMessage() {}
 
-func (x *GetIncidentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_incident_proto_msgTypes[2]
+func (x *TimelineEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,44 +1630,45 @@ func (x *GetIncidentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIncidentRequest.ProtoReflect.Descriptor instead.
-func (*GetIncidentRequest) Descriptor() ([]byte, []int) {
-	return file_incident_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TimelineEntryResponse.ProtoReflect.Descriptor instead.
+func (*TimelineEntryResponse) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetIncidentRequest) GetIncidentID() string {
+func (x *TimelineEntryResponse) GetEntry() *TimelineEntry {
 	if x != nil {
-		return x.IncidentID
+		return x.Entry
 	}
-	return ""
+	return nil
 }
 
-type UpdateIncidentRequest struct {
+type GetIncidentTimelineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	IncidentID string `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
-	Status     string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize   int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken  string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *UpdateIncidentRequest) Reset() {
-	*x = UpdateIncidentRequest{}
+func (x *GetIncidentTimelineRequest) Reset() {
+	*x = GetIncidentTimelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_incident_proto_msgTypes[3]
+		mi := &file_incident_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateIncidentRequest) String() string {
+func (x *GetIncidentTimelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateIncidentRequest) ProtoMessage() {}
+func (*GetIncidentTimelineRequest) ProtoMessage() {}
 
-func (x *UpdateIncidentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_incident_proto_msgTypes[3]
+func (x *GetIncidentTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,50 +1679,59 @@ func (x *UpdateIncidentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateIncidentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateIncidentRequest) Descriptor() ([]byte, []int) {
-	return file_incident_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetIncidentTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetIncidentTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateIncidentRequest) GetIncidentID() string {
+func (x *GetIncidentTimelineRequest) GetIncidentID() string {
 	if x != nil {
 		return x.IncidentID
 	}
 	return ""
 }
 
-func (x *UpdateIncidentRequest) GetStatus() string {
+func (x *GetIncidentTimelineRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Status
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetIncidentTimelineRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type DeleteIncidentRequest struct {
+// Entries in chronological order.
+type GetIncidentTimelineResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IncidentID string `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Entries       []*TimelineEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *DeleteIncidentRequest) Reset() {
-	*x = DeleteIncidentRequest{}
+func (x *GetIncidentTimelineResponse) Reset() {
+	*x = GetIncidentTimelineResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_incident_proto_msgTypes[4]
+		mi := &file_incident_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteIncidentRequest) String() string {
+func (x *GetIncidentTimelineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIncidentRequest) ProtoMessage() {}
+func (*GetIncidentTimelineResponse) ProtoMessage() {}
 
-func (x *DeleteIncidentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_incident_proto_msgTypes[4]
+func (x *GetIncidentTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,43 +1742,53 @@ func (x *DeleteIncidentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIncidentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteIncidentRequest) Descriptor() ([]byte, []int) {
-	return file_incident_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetIncidentTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetIncidentTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteIncidentRequest) GetIncidentID() string {
+func (x *GetIncidentTimelineResponse) GetEntries() []*TimelineEntry {
 	if x != nil {
-		return x.IncidentID
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetIncidentTimelineResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type IncidentResponse struct {
+// Empty filters match every incident. An update matches when the incident after the change does.
+type WatchIncidentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Incident *IncidentProto `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`
+	Status   IncidentStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=main.IncidentStatus" json:"status,omitempty"`
+	Severity IncidentSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=main.IncidentSeverity" json:"severity,omitempty"`
+	Zone     string           `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
 }
 
-func (x *IncidentResponse) Reset() {
-	*x = IncidentResponse{}
+func (x *WatchIncidentsRequest) Reset() {
+	*x = WatchIncidentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_incident_proto_msgTypes[5]
+		mi := &file_incident_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IncidentResponse) String() string {
+func (x *WatchIncidentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncidentResponse) ProtoMessage() {}
+func (*WatchIncidentsRequest) ProtoMessage() {}
 
-func (x *IncidentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_incident_proto_msgTypes[5]
+func (x *WatchIncidentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,43 +1799,59 @@ func (x *IncidentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncidentResponse.ProtoReflect.Descriptor instead.
-func (*IncidentResponse) Descriptor() ([]byte, []int) {
-	return file_incident_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use WatchIncidentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchIncidentsRequest) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *IncidentResponse) GetIncident() *IncidentProto {
+func (x *WatchIncidentsRequest) GetStatus() IncidentStatus {
 	if x != nil {
-		return x.Incident
+		return x.Status
 	}
-	return nil
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
 }
 
-type DeleteIncidentResponse struct {
+func (x *WatchIncidentsRequest) GetSeverity() IncidentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *WatchIncidentsRequest) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+type IncidentChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Type      IncidentChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=main.IncidentChangeType" json:"type,omitempty"`
+	Incident  *IncidentProto     `protobuf:"bytes,2,opt,name=incident,proto3" json:"incident,omitempty"`
+	ChangedAt string             `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
 }
 
-func (x *DeleteIncidentResponse) Reset() {
-	*x = DeleteIncidentResponse{}
+func (x *IncidentChange) Reset() {
+	*x = IncidentChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_incident_proto_msgTypes[6]
+		mi := &file_incident_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteIncidentResponse) String() string {
+func (x *IncidentChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIncidentResponse) ProtoMessage() {}
+func (*IncidentChange) ProtoMessage() {}
 
-func (x *DeleteIncidentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_incident_proto_msgTypes[6]
+func (x *IncidentChange) ProtoReflect() protoreflect.Message {
+	mi := &file_incident_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,82 +1862,438 @@ func (x *DeleteIncidentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIncidentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteIncidentResponse) Descriptor() ([]byte, []int) {
-	return file_incident_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use IncidentChange.ProtoReflect.Descriptor instead.
+func (*IncidentChange) Descriptor() ([]byte, []int) {
+	return file_incident_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteIncidentResponse) GetSuccess() bool {
+func (x *IncidentChange) GetType() IncidentChangeType {
 	if x != nil {
-		return x.Success
+		return x.Type
 	}
-	return false
+	return IncidentChangeType_INCIDENT_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *IncidentChange) GetIncident() *IncidentProto {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+func (x *IncidentChange) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
 }
 
 var File_incident_proto protoreflect.FileDescriptor
 
 var file_incident_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x04, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x63, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
-	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x8c,
-	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x35, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x04, 0x6d, 0x61, 0x69, 0x6e, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x11, 0xc2, 0xf3, 0x18, 0x0d, 0x10, 0xa6, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x18, 0x5a, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xc2, 0xf3, 0x18, 0x0e, 0x10, 0xcc, 0xfe, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x18, 0xb4, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x64, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65,
+	0x22, 0x6b, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x20, 0xc8, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x20, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1d,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2,
+	0xf3, 0x18, 0x03, 0x20, 0xfe, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xcc, 0x07,
+	0x0a, 0x0d, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x43, 0x0a, 0x10, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e,
-	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x69, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
-	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0xad, 0x02, 0x0a, 0x0f, 0x49, 0x6e,
-	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12,
-	0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d,
-	0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
-	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e,
+	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e,
+	0x0a, 0x0b, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f,
+	0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x65, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x17,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x14, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4c, 0x69,
+	0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x03, 0x52, 0x12, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0xc7, 0x05, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xc2, 0xf3, 0x18, 0x05, 0x08, 0x01, 0x20, 0xc8, 0x01,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3,
+	0x18, 0x03, 0x20, 0xd0, 0x0f, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x02, 0x20,
+	0x32, 0x18, 0x01, 0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x36, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xc2, 0xf3, 0x18, 0x0d, 0x08, 0x01,
+	0x32, 0x09, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x20, 0x80, 0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x00, 0x18, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0f, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65,
+	0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x09, 0xc2, 0xf3, 0x18, 0x05, 0x10, 0x00, 0x18, 0xe8, 0x07, 0x52, 0x0e, 0x70, 0x65, 0x6f, 0x70,
+	0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x16, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04,
+	0x10, 0x01, 0x40, 0x01, 0x52, 0x14, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4c, 0x69,
+	0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x14, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x01,
+	0x40, 0x01, 0x52, 0x12, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0b,
+	0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x99, 0x03, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x69, 0x6e,
+	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x32, 0x18, 0x01, 0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xc2, 0xf3, 0x18, 0x03, 0x20, 0xd0, 0x0f, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x20, 0xd0, 0x0f, 0x52, 0x12, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x48, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x6c, 0x69,
+	0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x12, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x10, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x73, 0x22, 0x2f, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x40, 0x01, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x22, 0x40, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0b, 0x69,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0xf7, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0f, 0xc2, 0xf3, 0x18, 0x0b, 0x32, 0x09, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x74, 0x69,
+	0x6d, 0x65, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x2e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0f, 0xc2, 0xf3, 0x18, 0x0b, 0x32, 0x09, 0x64, 0x61, 0x74, 0x65, 0x2d,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12,
+	0x25, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x00, 0x18, 0x64, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03,
+	0x20, 0xd0, 0x0f, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8c,
+	0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x09, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a,
+	0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x69, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x08, 0x01, 0x20, 0x64, 0x38, 0x64, 0x52, 0x0b, 0x69, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x69,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x43, 0x0a, 0x10, 0x49, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08,
+	0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x22, 0x32, 0x0a,
+	0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0xf3, 0x02, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31,
+	0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0x6e, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x49, 0x6e,
+	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0a,
+	0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xc2, 0xf3, 0x18, 0x05, 0x08, 0x01,
+	0x20, 0xd0, 0x0f, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x8b, 0x01, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0b, 0x69, 0x6e,
+	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xc2, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x00, 0x18, 0x64,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x95, 0x01, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x04, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20,
+	0x64, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x08, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x2a, 0xc0, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x49,
+	0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e,
+	0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x43, 0x49,
+	0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c, 0x45,
+	0x44, 0x47, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54,
+	0x43, 0x48, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x45,
+	0x4e, 0x45, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x08, 0x22, 0x04, 0x08, 0x02, 0x10, 0x02, 0x2a, 0x13, 0x49, 0x4e, 0x43, 0x49, 0x44,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x2a, 0x1b,
+	0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x2a, 0xac, 0x01, 0x0a, 0x10,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56,
+	0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x1e,
+	0x0a, 0x1a, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1a,
+	0x0a, 0x16, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e,
+	0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x04, 0x2a, 0xd1, 0x01, 0x0a, 0x10, 0x49,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x44, 0x52, 0x4f, 0x57, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x4a, 0x55, 0x52, 0x59, 0x10, 0x02,
+	0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x54,
+	0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x4f, 0x53, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x4f,
+	0x4e, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x48, 0x41, 0x5a, 0x41, 0x52, 0x44, 0x10,
+	0x04, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x05, 0x2a, 0xc1,
+	0x01, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45,
+	0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x49, 0x4d,
+	0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x4e, 0x54,
+	0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x12, 0x22,
+	0x0a, 0x1e, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54,
+	0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45,
+	0x4e, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d,
+	0x10, 0x04, 0x2a, 0xa0, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x43,
+	0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x32, 0xde, 0x07, 0x0a, 0x0f, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76, 0x32,
+	0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x6d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a,
+	0x01, 0x2a, 0x32, 0x1b, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x70, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
 	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61,
-	0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x5f, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x74, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x12, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x3a,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x12, 0x7a, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x49,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01,
+	0x2a, 0x22, 0x21, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x32, 0x2f, 0x69,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x45, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e,
+	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -491,31 +2308,86 @@ func file_incident_proto_rawDescGZIP() []byte {
 	return file_incident_proto_rawDescData
 }
 
-var file_incident_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_incident_proto_goTypes = []interface{}{
-	(*IncidentProto)(nil),          // 0: main.IncidentProto
-	(*CreateIncidentRequest)(nil),  // 1: main.CreateIncidentRequest
-	(*GetIncidentRequest)(nil),     // 2: main.GetIncidentRequest
-	(*UpdateIncidentRequest)(nil),  // 3: main.UpdateIncidentRequest
-	(*DeleteIncidentRequest)(nil),  // 4: main.DeleteIncidentRequest
-	(*IncidentResponse)(nil),       // 5: main.IncidentResponse
-	(*DeleteIncidentResponse)(nil), // 6: main.DeleteIncidentResponse
+var file_incident_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_incident_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_incident_proto_goTypes = []any{
+	(IncidentStatus)(0),                 // 0: main.IncidentStatus
+	(IncidentSeverity)(0),               // 1: main.IncidentSeverity
+	(IncidentCategory)(0),               // 2: main.IncidentCategory
+	(TimelineEntryType)(0),              // 3: main.TimelineEntryType
+	(IncidentChangeType)(0),             // 4: main.IncidentChangeType
+	(*Location)(nil),                    // 5: main.Location
+	(*ReporterContact)(nil),             // 6: main.ReporterContact
+	(*IncidentProto)(nil),               // 7: main.IncidentProto
+	(*CreateIncidentRequest)(nil),       // 8: main.CreateIncidentRequest
+	(*GetIncidentRequest)(nil),          // 9: main.GetIncidentRequest
+	(*UpdateIncidentRequest)(nil),       // 10: main.UpdateIncidentRequest
+	(*AssignedResources)(nil),           // 11: main.AssignedResources
+	(*DeleteIncidentRequest)(nil),       // 12: main.DeleteIncidentRequest
+	(*ListIncidentsRequest)(nil),        // 13: main.ListIncidentsRequest
+	(*ListIncidentsResponse)(nil),       // 14: main.ListIncidentsResponse
+	(*BatchGetIncidentsRequest)(nil),    // 15: main.BatchGetIncidentsRequest
+	(*BatchGetIncidentsResponse)(nil),   // 16: main.BatchGetIncidentsResponse
+	(*IncidentResponse)(nil),            // 17: main.IncidentResponse
+	(*DeleteIncidentResponse)(nil),      // 18: main.DeleteIncidentResponse
+	(*TimelineEntry)(nil),               // 19: main.TimelineEntry
+	(*AddIncidentNoteRequest)(nil),      // 20: main.AddIncidentNoteRequest
+	(*TimelineEntryResponse)(nil),       // 21: main.TimelineEntryResponse
+	(*GetIncidentTimelineRequest)(nil),  // 22: main.GetIncidentTimelineRequest
+	(*GetIncidentTimelineResponse)(nil), // 23: main.GetIncidentTimelineResponse
+	(*WatchIncidentsRequest)(nil),       // 24: main.WatchIncidentsRequest
+	(*IncidentChange)(nil),              // 25: main.IncidentChange
 }
 var file_incident_proto_depIdxs = []int32{
-	0, // 0: main.IncidentResponse.incident:type_name -> main.IncidentProto
-	1, // 1: main.IncidentService.CreateIncident:input_type -> main.CreateIncidentRequest
-	2, // 2: main.IncidentService.GetIncident:input_type -> main.GetIncidentRequest
-	3, // 3: main.IncidentService.UpdateIncident:input_type -> main.UpdateIncidentRequest
-	4, // 4: main.IncidentService.DeleteIncident:input_type -> main.DeleteIncidentRequest
-	5, // 5: main.IncidentService.CreateIncident:output_type -> main.IncidentResponse
-	5, // 6: main.IncidentService.GetIncident:output_type -> main.IncidentResponse
-	5, // 7: main.IncidentService.UpdateIncident:output_type -> main.IncidentResponse
-	6, // 8: main.IncidentService.DeleteIncident:output_type -> main.DeleteIncidentResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: main.IncidentProto.status:type_name -> main.IncidentStatus
+	1,  // 1: main.IncidentProto.severity:type_name -> main.IncidentSeverity
+	2,  // 2: main.IncidentProto.category:type_name -> main.IncidentCategory
+	5,  // 3: main.IncidentProto.location:type_name -> main.Location
+	6,  // 4: main.IncidentProto.reporter:type_name -> main.ReporterContact
+	0,  // 5: main.CreateIncidentRequest.status:type_name -> main.IncidentStatus
+	1,  // 6: main.CreateIncidentRequest.severity:type_name -> main.IncidentSeverity
+	2,  // 7: main.CreateIncidentRequest.category:type_name -> main.IncidentCategory
+	5,  // 8: main.CreateIncidentRequest.location:type_name -> main.Location
+	6,  // 9: main.CreateIncidentRequest.reporter:type_name -> main.ReporterContact
+	0,  // 10: main.UpdateIncidentRequest.status:type_name -> main.IncidentStatus
+	11, // 11: main.UpdateIncidentRequest.assigned_lifeguards:type_name -> main.AssignedResources
+	11, // 12: main.UpdateIncidentRequest.assigned_vehicles:type_name -> main.AssignedResources
+	0,  // 13: main.ListIncidentsRequest.status:type_name -> main.IncidentStatus
+	7,  // 14: main.ListIncidentsResponse.incidents:type_name -> main.IncidentProto
+	7,  // 15: main.BatchGetIncidentsResponse.incidents:type_name -> main.IncidentProto
+	7,  // 16: main.IncidentResponse.incident:type_name -> main.IncidentProto
+	3,  // 17: main.TimelineEntry.type:type_name -> main.TimelineEntryType
+	0,  // 18: main.TimelineEntry.from_status:type_name -> main.IncidentStatus
+	0,  // 19: main.TimelineEntry.to_status:type_name -> main.IncidentStatus
+	19, // 20: main.TimelineEntryResponse.entry:type_name -> main.TimelineEntry
+	19, // 21: main.GetIncidentTimelineResponse.entries:type_name -> main.TimelineEntry
+	0,  // 22: main.WatchIncidentsRequest.status:type_name -> main.IncidentStatus
+	1,  // 23: main.WatchIncidentsRequest.severity:type_name -> main.IncidentSeverity
+	4,  // 24: main.IncidentChange.type:type_name -> main.IncidentChangeType
+	7,  // 25: main.IncidentChange.incident:type_name -> main.IncidentProto
+	8,  // 26: main.IncidentService.CreateIncident:input_type -> main.CreateIncidentRequest
+	9,  // 27: main.IncidentService.GetIncident:input_type -> main.GetIncidentRequest
+	10, // 28: main.IncidentService.UpdateIncident:input_type -> main.UpdateIncidentRequest
+	12, // 29: main.IncidentService.DeleteIncident:input_type -> main.DeleteIncidentRequest
+	13, // 30: main.IncidentService.ListIncidents:input_type -> main.ListIncidentsRequest
+	15, // 31: main.IncidentService.BatchGetIncidents:input_type -> main.BatchGetIncidentsRequest
+	20, // 32: main.IncidentService.AddIncidentNote:input_type -> main.AddIncidentNoteRequest
+	22, // 33: main.IncidentService.GetIncidentTimeline:input_type -> main.GetIncidentTimelineRequest
+	24, // 34: main.IncidentService.WatchIncidents:input_type -> main.WatchIncidentsRequest
+	17, // 35: main.IncidentService.CreateIncident:output_type -> main.IncidentResponse
+	17, // 36: main.IncidentService.GetIncident:output_type -> main.IncidentResponse
+	17, // 37: main.IncidentService.UpdateIncident:output_type -> main.IncidentResponse
+	18, // 38: main.IncidentService.DeleteIncident:output_type -> main.DeleteIncidentResponse
+	14, // 39: main.IncidentService.ListIncidents:output_type -> main.ListIncidentsResponse
+	16, // 40: main.IncidentService.BatchGetIncidents:output_type -> main.BatchGetIncidentsResponse
+	21, // 41: main.IncidentService.AddIncidentNote:output_type -> main.TimelineEntryResponse
+	23, // 42: main.IncidentService.GetIncidentTimeline:output_type -> main.GetIncidentTimelineResponse
+	25, // 43: main.IncidentService.WatchIncidents:output_type -> main.IncidentChange
+	35, // [35:44] is the sub-list for method output_type
+	26, // [26:35] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_incident_proto_init() }
@@ -524,7 +2396,31 @@ func file_incident_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_incident_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_incident_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ReporterContact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*IncidentProto); i {
 			case 0:
 				return &v.state
@@ -536,7 +2432,7 @@ func file_incident_proto_init() {
 				return nil
 			}
 		}
-		file_incident_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_incident_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateIncidentRequest); i {
 			case 0:
 				return &v.state
@@ -548,7 +2444,7 @@ func file_incident_proto_init() {
 				return nil
 			}
 		}
-		file_incident_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_incident_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*GetIncidentRequest); i {
 			case 0:
 				return &v.state
@@ -560,7 +2456,7 @@ func file_incident_proto_init() {
 				return nil
 			}
 		}
-		file_incident_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_incident_proto_msgTypes[5].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateIncidentRequest); i {
 			case 0:
 				return &v.state
@@ -572,7 +2468,19 @@ func file_incident_proto_init() {
 				return nil
 			}
 		}
-		file_incident_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_incident_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*AssignedResources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[7].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteIncidentRequest); i {
 			case 0:
 				return &v.state
@@ -584,7 +2492,55 @@ func file_incident_proto_init() {
 				return nil
 			}
 		}
-		file_incident_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_incident_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ListIncidentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ListIncidentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetIncidentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetIncidentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*IncidentResponse); i {
 			case 0:
 				return &v.state
@@ -596,7 +2552,7 @@ func file_incident_proto_init() {
 				return nil
 			}
 		}
-		file_incident_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_incident_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteIncidentResponse); i {
 			case 0:
 				return &v.state
@@ -608,19 +2564,104 @@ func file_incident_proto_init() {
 				return nil
 			}
 		}
+		file_incident_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*TimelineEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*AddIncidentNoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*TimelineEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetIncidentTimelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GetIncidentTimelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchIncidentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_incident_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*IncidentChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_incident_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_incident_proto_goTypes,
 		DependencyIndexes: file_incident_proto_depIdxs,
+		EnumInfos:         file_incident_proto_enumTypes,
 		MessageInfos:      file_incident_proto_msgTypes,
 	}.Build()
 	File_incident_proto = out.File
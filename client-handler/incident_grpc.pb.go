@@ -22,6 +22,12 @@ type IncidentServiceClient interface {
 	GetIncident(ctx context.Context, in *GetIncidentRequest, opts ...grpc.CallOption) (*IncidentResponse, error)
 	UpdateIncident(ctx context.Context, in *UpdateIncidentRequest, opts ...grpc.CallOption) (*IncidentResponse, error)
 	DeleteIncident(ctx context.Context, in *DeleteIncidentRequest, opts ...grpc.CallOption) (*DeleteIncidentResponse, error)
+	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
+	BatchGetIncidents(ctx context.Context, in *BatchGetIncidentsRequest, opts ...grpc.CallOption) (*BatchGetIncidentsResponse, error)
+	AddIncidentNote(ctx context.Context, in *AddIncidentNoteRequest, opts ...grpc.CallOption) (*TimelineEntryResponse, error)
+	GetIncidentTimeline(ctx context.Context, in *GetIncidentTimelineRequest, opts ...grpc.CallOption) (*GetIncidentTimelineResponse, error)
+	// Streams changes made after the call, until the client cancels it.
+	WatchIncidents(ctx context.Context, in *WatchIncidentsRequest, opts ...grpc.CallOption) (IncidentService_WatchIncidentsClient, error)
 }
 
 type incidentServiceClient struct {
@@ -68,6 +74,74 @@ func (c *incidentServiceClient) DeleteIncident(ctx context.Context, in *DeleteIn
 	return out, nil
 }
 
+func (c *incidentServiceClient) ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error) {
+	out := new(ListIncidentsResponse)
+	err := c.cc.Invoke(ctx, "/main.IncidentService/ListIncidents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) BatchGetIncidents(ctx context.Context, in *BatchGetIncidentsRequest, opts ...grpc.CallOption) (*BatchGetIncidentsResponse, error) {
+	out := new(BatchGetIncidentsResponse)
+	err := c.cc.Invoke(ctx, "/main.IncidentService/BatchGetIncidents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) AddIncidentNote(ctx context.Context, in *AddIncidentNoteRequest, opts ...grpc.CallOption) (*TimelineEntryResponse, error) {
+	out := new(TimelineEntryResponse)
+	err := c.cc.Invoke(ctx, "/main.IncidentService/AddIncidentNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) GetIncidentTimeline(ctx context.Context, in *GetIncidentTimelineRequest, opts ...grpc.CallOption) (*GetIncidentTimelineResponse, error) {
+	out := new(GetIncidentTimelineResponse)
+	err := c.cc.Invoke(ctx, "/main.IncidentService/GetIncidentTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) WatchIncidents(ctx context.Context, in *WatchIncidentsRequest, opts ...grpc.CallOption) (IncidentService_WatchIncidentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &IncidentService_ServiceDesc.Streams[0], "/main.IncidentService/WatchIncidents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &incidentServiceWatchIncidentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IncidentService_WatchIncidentsClient interface {
+	Recv() (*IncidentChange, error)
+	grpc.ClientStream
+}
+
+type incidentServiceWatchIncidentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *incidentServiceWatchIncidentsClient) Recv() (*IncidentChange, error) {
+	m := new(IncidentChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // IncidentServiceServer is the server API for IncidentService service.
 // All implementations must embed UnimplementedIncidentServiceServer
 // for forward compatibility
@@ -76,6 +150,12 @@ type IncidentServiceServer interface {
 	GetIncident(context.Context, *GetIncidentRequest) (*IncidentResponse, error)
 	UpdateIncident(context.Context, *UpdateIncidentRequest) (*IncidentResponse, error)
 	DeleteIncident(context.Context, *DeleteIncidentRequest) (*DeleteIncidentResponse, error)
+	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
+	BatchGetIncidents(context.Context, *BatchGetIncidentsRequest) (*BatchGetIncidentsResponse, error)
+	AddIncidentNote(context.Context, *AddIncidentNoteRequest) (*TimelineEntryResponse, error)
+	GetIncidentTimeline(context.Context, *GetIncidentTimelineRequest) (*GetIncidentTimelineResponse, error)
+	// Streams changes made after the call, until the client cancels it.
+	WatchIncidents(*WatchIncidentsRequest, IncidentService_WatchIncidentsServer) error
 	mustEmbedUnimplementedIncidentServiceServer()
 }
 
@@ -95,6 +175,21 @@ func (UnimplementedIncidentServiceServer) UpdateIncident(context.Context, *Updat
 func (UnimplementedIncidentServiceServer) DeleteIncident(context.Context, *DeleteIncidentRequest) (*DeleteIncidentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteIncident not implemented")
 }
+func (UnimplementedIncidentServiceServer) ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncidents not implemented")
+}
+func (UnimplementedIncidentServiceServer) BatchGetIncidents(context.Context, *BatchGetIncidentsRequest) (*BatchGetIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetIncidents not implemented")
+}
+func (UnimplementedIncidentServiceServer) AddIncidentNote(context.Context, *AddIncidentNoteRequest) (*TimelineEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIncidentNote not implemented")
+}
+func (UnimplementedIncidentServiceServer) GetIncidentTimeline(context.Context, *GetIncidentTimelineRequest) (*GetIncidentTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentTimeline not implemented")
+}
+func (UnimplementedIncidentServiceServer) WatchIncidents(*WatchIncidentsRequest, IncidentService_WatchIncidentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchIncidents not implemented")
+}
 func (UnimplementedIncidentServiceServer) mustEmbedUnimplementedIncidentServiceServer() {}
 
 // UnsafeIncidentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +275,99 @@ func _IncidentService_DeleteIncident_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IncidentService_ListIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).ListIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.IncidentService/ListIncidents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).ListIncidents(ctx, req.(*ListIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_BatchGetIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).BatchGetIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.IncidentService/BatchGetIncidents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).BatchGetIncidents(ctx, req.(*BatchGetIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_AddIncidentNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddIncidentNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).AddIncidentNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.IncidentService/AddIncidentNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).AddIncidentNote(ctx, req.(*AddIncidentNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_GetIncidentTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncidentTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).GetIncidentTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.IncidentService/GetIncidentTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).GetIncidentTimeline(ctx, req.(*GetIncidentTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_WatchIncidents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchIncidentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IncidentServiceServer).WatchIncidents(m, &incidentServiceWatchIncidentsServer{stream})
+}
+
+type IncidentService_WatchIncidentsServer interface {
+	Send(*IncidentChange) error
+	grpc.ServerStream
+}
+
+type incidentServiceWatchIncidentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *incidentServiceWatchIncidentsServer) Send(m *IncidentChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // IncidentService_ServiceDesc is the grpc.ServiceDesc for IncidentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,7 +391,29 @@ var IncidentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteIncident",
 			Handler:    _IncidentService_DeleteIncident_Handler,
 		},
+		{
+			MethodName: "ListIncidents",
+			Handler:    _IncidentService_ListIncidents_Handler,
+		},
+		{
+			MethodName: "BatchGetIncidents",
+			Handler:    _IncidentService_BatchGetIncidents_Handler,
+		},
+		{
+			MethodName: "AddIncidentNote",
+			Handler:    _IncidentService_AddIncidentNote_Handler,
+		},
+		{
+			MethodName: "GetIncidentTimeline",
+			Handler:    _IncidentService_GetIncidentTimeline_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchIncidents",
+			Handler:       _IncidentService_WatchIncidents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "incident.proto",
 }
@@ -1,15 +1,24 @@
 package main
 
 import (
-	context "context"
 	"encoding/json"
 	"log"
 	"net/http"
 	"strconv"
-	"time"
 )
 
 type Lifeguard struct {
+	Id                int64  `json:"id,omitempty"`
+	Name              string `json:"name"`
+	Login             string `json:"login"`
+	YearsOfExperience int32  `json:"years_of_experience"`
+	Specialization    string `json:"specialization"`
+	OnMission         bool   `json:"on_mission"`
+	CreatedAt         string `json:"created_at,omitempty"`
+}
+
+// LifeguardInput is the body of create and update requests, the password hash is accepted but never returned.
+type LifeguardInput struct {
 	Name              string `json:"name"`
 	Login             string `json:"login"`
 	PasswordHash      string `json:"password_hash"`
@@ -21,26 +30,26 @@ type Lifeguard struct {
 var lifeguardClient LifeguardServiceClient
 
 func CreateLifeguardHandler(w http.ResponseWriter, r *http.Request) {
-	var lifeguard Lifeguard
+	var lifeguard LifeguardInput
 	err := json.NewDecoder(r.Body).Decode(&lifeguard)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
+	specialization, legacySpecialization := specializationFromString(lifeguard.Specialization)
 
-	lifeguardResponse, err := lifeguardClient.CreateLifeguard(ctx, &CreateLifeguardRequest{
-		Name:              lifeguard.Name,
-		Login:             lifeguard.Login,
-		PasswordHash:      lifeguard.PasswordHash,
-		YearsOfExperience: lifeguard.YearsOfExperience,
-		Specialization:    lifeguard.Specialization,
-		OnMission:         lifeguard.OnMission,
+	lifeguardResponse, err := lifeguardClient.CreateLifeguard(r.Context(), &CreateLifeguardRequest{
+		Name:                 lifeguard.Name,
+		Login:                lifeguard.Login,
+		PasswordHash:         lifeguard.PasswordHash,
+		YearsOfExperience:    lifeguard.YearsOfExperience,
+		LegacySpecialization: legacySpecialization,
+		OnMission:            lifeguard.OnMission,
+		Specialization:       specialization,
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGrpcError(w, r, err)
 		return
 	}
 
@@ -55,28 +64,25 @@ func GetLifeguardHandler(w http.ResponseWriter, r *http.Request) {
 
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		http.Error(w, "Niepoprawny format id podany przez użytkownika", http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, "Niepoprawny format id podany przez użytkownika")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
-
-	lifeguardResponse, err := lifeguardClient.GetLifeguard(ctx, &GetLifeguardRequest{Id: id})
+	lifeguardResponse, err := lifeguardClient.GetLifeguard(r.Context(), &GetLifeguardRequest{Id: id})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGrpcError(w, r, err)
 		return
 	}
 
 	log.Printf("Pobrano wiersz z tabeli lifeguards, id wiersza: %d\n", id)
-	json.NewEncoder(w).Encode(lifeguardResponse)
+	json.NewEncoder(w).Encode(lifeguardFromResponse(lifeguardResponse))
 }
 
 func UpdateLifeguardHandler(w http.ResponseWriter, r *http.Request) {
-	var lifeguard Lifeguard
+	var lifeguard LifeguardInput
 	err := json.NewDecoder(r.Body).Decode(&lifeguard)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -84,24 +90,24 @@ func UpdateLifeguardHandler(w http.ResponseWriter, r *http.Request) {
 
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		http.Error(w, "Niepoprawny format id podany przez użytkownika", http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, "Niepoprawny format id podany przez użytkownika")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
-
-	lifeguardResponse, err := lifeguardClient.UpdateLifeguard(ctx, &UpdateLifeguardRequest{
-		Id:                id,
-		Name:              lifeguard.Name,
-		Login:             lifeguard.Login,
-		PasswordHash:      lifeguard.PasswordHash,
-		YearsOfExperience: lifeguard.YearsOfExperience,
-		Specialization:    lifeguard.Specialization,
-		OnMission:         lifeguard.OnMission,
+	specialization, legacySpecialization := specializationFromString(lifeguard.Specialization)
+
+	lifeguardResponse, err := lifeguardClient.UpdateLifeguard(r.Context(), &UpdateLifeguardRequest{
+		Id:                   id,
+		Name:                 lifeguard.Name,
+		Login:                lifeguard.Login,
+		PasswordHash:         lifeguard.PasswordHash,
+		YearsOfExperience:    lifeguard.YearsOfExperience,
+		LegacySpecialization: legacySpecialization,
+		OnMission:            lifeguard.OnMission,
+		Specialization:       specialization,
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGrpcError(w, r, err)
 		return
 	}
 
@@ -115,16 +121,13 @@ func DeleteLifeguardHandler(w http.ResponseWriter, r *http.Request) {
 
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		http.Error(w, "Niepoprawny format id podany przez użytkownika", http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, "Niepoprawny format id podany przez użytkownika")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
-
-	_, err = lifeguardClient.DeleteLifeguard(ctx, &DeleteLifeguardRequest{Id: id})
+	_, err = lifeguardClient.DeleteLifeguard(r.Context(), &DeleteLifeguardRequest{Id: id})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGrpcError(w, r, err)
 		return
 	}
 
@@ -132,3 +135,15 @@ func DeleteLifeguardHandler(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func lifeguardFromResponse(lifeguardResponse *GetLifeguardResponse) Lifeguard {
+	return Lifeguard{
+		Id:                lifeguardResponse.Id,
+		Name:              lifeguardResponse.Name,
+		Login:             lifeguardResponse.Login,
+		YearsOfExperience: lifeguardResponse.YearsOfExperience,
+		Specialization:    specializationToString(lifeguardResponse.Specialization, lifeguardResponse.LegacySpecialization),
+		OnMission:         lifeguardResponse.OnMission,
+		CreatedAt:         lifeguardResponse.CreatedAt,
+	}
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+)
+
+// The password hash is accepted in mutations, but never returned.
+var lifeguardType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "Lifeguard",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.Int,
+			},
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"login": &graphql.Field{
+				Type: graphql.String,
+			},
+			"yearsOfExperience": &graphql.Field{
+				Type: graphql.Int,
+			},
+			"specialization": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					lifeguard, ok := p.Source.(*GetLifeguardResponse)
+					if !ok {
+						return nil, nil
+					}
+					return specializationToString(lifeguard.Specialization, lifeguard.LegacySpecialization), nil
+				},
+			},
+			"onMission": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			"createdAt": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	},
+)
+
+// Lifeguard.vehicles refers back to the Vehicle type, so it is added once both types exist.
+func init() {
+	lifeguardType.AddFieldConfig("vehicles", &graphql.Field{
+		Type:    graphql.NewList(graphql.NewNonNull(vehicleType)),
+		Resolve: resolveLifeguardVehicles,
+	})
+}
+
+func fetchLifeguard(ctx context.Context, id int64) (*GetLifeguardResponse, error) {
+	lifeguard, err := lifeguardClient.GetLifeguard(ctx, &GetLifeguardRequest{Id: id})
+	if err != nil {
+		log.Printf("Nie udało się pobrać ratownika o id: %d, error: %v\n", id, err)
+		return nil, graphqlServiceError("emergency-services", err)
+	}
+	return lifeguard, nil
+}
+
+func resolveLifeguardVehicles(p graphql.ResolveParams) (interface{}, error) {
+	lifeguard, ok := p.Source.(*GetLifeguardResponse)
+	if !ok {
+		return nil, nil
+	}
+	return resolveLoaded("emergency-services", loadersFrom(p.Context).vehiclesByLifeguard.Load(p.Context, lifeguard.Id)), nil
+}
+
+var lifeguardField = &graphql.Field{
+	Type: lifeguardType,
+	Args: graphql.FieldConfigArgument{
+		"id": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		id := p.Args["id"].(int)
+		return resolveLoaded("emergency-services", loadersFrom(p.Context).lifeguards.Load(p.Context, int64(id))), nil
+	},
+}
+
+var lifeguardsField = &graphql.Field{
+	Type: graphql.NewList(graphql.NewNonNull(lifeguardType)),
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+
+		resp, err := lifeguardClient.ListLifeguards(p.Context, &ListLifeguardsRequest{})
+		if err != nil {
+			log.Printf("Nie udało się pobrać listy ratowników, error: %v\n", err)
+			return nil, graphqlServiceError("emergency-services", err)
+		}
+
+		log.Printf("Pobrano %d ratowników\n", len(resp.Lifeguards))
+		return resp.Lifeguards, nil
+	},
+}
+
+var createLifeguardField = &graphql.Field{
+	Type: lifeguardType,
+	Args: graphql.FieldConfigArgument{
+		"name": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"login": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"passwordHash": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"yearsOfExperience": &graphql.ArgumentConfig{
+			Type: graphql.Int,
+		},
+		"specialization": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"onMission": &graphql.ArgumentConfig{
+			Type: graphql.Boolean,
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		yearsOfExperience, _ := p.Args["yearsOfExperience"].(int)
+		specializationName, _ := p.Args["specialization"].(string)
+		onMission, _ := p.Args["onMission"].(bool)
+		specialization, legacySpecialization := specializationFromString(specializationName)
+
+		resp, err := lifeguardClient.CreateLifeguard(p.Context, &CreateLifeguardRequest{
+			Name:                 p.Args["name"].(string),
+			Login:                p.Args["login"].(string),
+			PasswordHash:         p.Args["passwordHash"].(string),
+			YearsOfExperience:    int32(yearsOfExperience),
+			LegacySpecialization: legacySpecialization,
+			OnMission:            onMission,
+			Specialization:       specialization,
+		})
+		if err != nil {
+			log.Printf("Nie udało się utworzyć ratownika, error: %v\n", err)
+			return nil, graphqlServiceError("emergency-services", err)
+		}
+
+		log.Printf("Utworzono ratownika o id: %d\n", resp.Id)
+		return fetchLifeguard(p.Context, resp.Id)
+	},
+}
+
+// Fields left out of the mutation keep their current values.
+var updateLifeguardField = &graphql.Field{
+	Type: lifeguardType,
+	Args: graphql.FieldConfigArgument{
+		"id": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"name": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"login": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"passwordHash": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"yearsOfExperience": &graphql.ArgumentConfig{
+			Type: graphql.Int,
+		},
+		"specialization": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"onMission": &graphql.ArgumentConfig{
+			Type: graphql.Boolean,
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		id := int64(p.Args["id"].(int))
+
+		current, err := fetchLifeguard(p.Context, id)
+		if err != nil {
+			return nil, err
+		}
+
+		req := &UpdateLifeguardRequest{
+			Id:                   id,
+			Name:                 current.Name,
+			Login:                current.Login,
+			PasswordHash:         current.PasswordHash,
+			YearsOfExperience:    current.YearsOfExperience,
+			LegacySpecialization: current.LegacySpecialization,
+			OnMission:            current.OnMission,
+			Specialization:       current.Specialization,
+		}
+		if name, ok := p.Args["name"].(string); ok {
+			req.Name = name
+		}
+		if login, ok := p.Args["login"].(string); ok {
+			req.Login = login
+		}
+		if passwordHash, ok := p.Args["passwordHash"].(string); ok {
+			req.PasswordHash = passwordHash
+		}
+		if yearsOfExperience, ok := p.Args["yearsOfExperience"].(int); ok {
+			req.YearsOfExperience = int32(yearsOfExperience)
+		}
+		if specialization, ok := p.Args["specialization"].(string); ok {
+			req.Specialization, req.LegacySpecialization = specializationFromString(specialization)
+		}
+		if onMission, ok := p.Args["onMission"].(bool); ok {
+			req.OnMission = onMission
+		}
+
+		if _, err := lifeguardClient.UpdateLifeguard(p.Context, req); err != nil {
+			log.Printf("Nie udało się zaktualizować ratownika o id: %d, error: %v\n", id, err)
+			return nil, graphqlServiceError("emergency-services", err)
+		}
+
+		log.Printf("Zaktualizowano ratownika o id: %d\n", id)
+		return fetchLifeguard(p.Context, id)
+	},
+}
+
+var deleteLifeguardField = &graphql.Field{
+	Type: deleteResponseType,
+	Args: graphql.FieldConfigArgument{
+		"id": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		id := p.Args["id"].(int)
+
+		if _, err := lifeguardClient.DeleteLifeguard(p.Context, &DeleteLifeguardRequest{Id: int64(id)}); err != nil {
+			log.Printf("Nie udało się usunąć ratownika o id: %d, error: %v\n", id, err)
+			return nil, graphqlServiceError("emergency-services", err)
+		}
+
+		log.Printf("Usunięto ratownika o id: %d\n", id)
+		return map[string]interface{}{
+			"success": true,
+		}, nil
+	},
+}
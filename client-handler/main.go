@@ -1,50 +1,96 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 
+	"github.com/szbobrowski/master-thesis/auth"
+	"github.com/szbobrowski/master-thesis/auth/mtls"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func main() {
-	restConn, err := grpc.Dial("localhost:50051", grpc.WithInsecure(), grpc.WithBlock())
+	limits, err := queryLimitsFromEnv()
 	if err != nil {
-		log.Fatalf("Nie udało się połączyć z serwerem gRPC emergency-services: %v", err)
+		log.Fatalf("Niepoprawna konfiguracja limitów GraphQL: %v", err)
 	}
-	log.Println("Połączono z serwerem gRPC emergency-services na localhost:50051")
-	defer restConn.Close()
+	queryLimits = limits
 
-	lifeguardClient = NewLifeguardServiceClient(restConn)
-	vehicleClient = NewVehicleServiceClient(restConn)
+	persistedQueries, err = persistedQueriesFromEnv()
+	if err != nil {
+		log.Fatalf("Nie udało się wczytać zapisanych zapytań GraphQL: %v", err)
+	}
+
+	tokenSigner, err = auth.SignerFromEnv()
+	if err != nil {
+		log.Fatalf("Nie udało się wczytać sekretu tokenów dostępu: %v", err)
+	}
+
+	tlsConfig, err := mtls.ConfigFromEnv("client-handler")
+	if err != nil {
+		log.Fatalf("Niepoprawna konfiguracja TLS: %v", err)
+	}
+	grpcCredentials, err = mtls.Load(context.Background(), tlsConfig)
+	if err != nil {
+		log.Fatalf("Nie udało się wczytać certyfikatów TLS: %v", err)
+	}
+
+	policy, err := clientPolicyFromEnv()
+	if err != nil {
+		log.Fatalf("Niepoprawna konfiguracja klientów gRPC: %v", err)
+	}
+
+	emergencyServices, err := dialBackend("emergency-services", "localhost:50051", policy)
+	if err != nil {
+		log.Fatalf("Nie udało się utworzyć klienta gRPC emergency-services: %v", err)
+	}
+	defer emergencyServices.Close()
+
+	lifeguardClient = NewLifeguardServiceClient(emergencyServices.Conn)
+	vehicleClient = NewVehicleServiceClient(emergencyServices.Conn)
+	apiKeyClient = NewApiKeyServiceClient(emergencyServices.Conn)
 
-	graphqlConn, err := grpc.Dial("localhost:50052", grpc.WithInsecure(), grpc.WithBlock())
+	incidentNotifier, err := dialBackend("incident-notifier", "localhost:50052", policy)
 	if err != nil {
-		log.Fatalf("Nie udało się połączyć z serwerem gRPC incident-notifier: %v", err)
+		log.Fatalf("Nie udało się utworzyć klienta gRPC incident-notifier: %v", err)
 	}
-	log.Println("Połączono z serwerem gRPC incident-notifier na localhost:50052")
-	defer graphqlConn.Close()
+	defer incidentNotifier.Close()
 
-	incidentClient = NewIncidentServiceClient(graphqlConn)
-	initIncidentGrpcClient()
+	incidentClient = NewIncidentServiceClient(incidentNotifier.Conn)
+
+	serviceToken := auth.ServiceTokenSource(tokenSigner, serviceTokenSubject, auth.RoleService)
+	apiKeys := NewApiKeyAuthenticator(apiKeyClient, tokenSigner, serviceToken)
+
+	incidentFeed = NewIncidentFeed(incidentClient, serviceToken)
+	go incidentFeed.Run(context.Background())
 
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/graphql", graphqlHandler)
 
-	mux.HandleFunc("/lifeguard", CreateLifeguardHandler)
-	mux.HandleFunc("/lifeguard/get", GetLifeguardHandler)
-	mux.HandleFunc("/lifeguard/update", UpdateLifeguardHandler)
-	mux.HandleFunc("/lifeguard/delete", DeleteLifeguardHandler)
+	registerRestRoutes(mux)
+
+	err = registerTranscodedRoutes(mux, map[protoreflect.FullName]grpc.ClientConnInterface{
+		"main.LifeguardService": emergencyServices.Conn,
+		"main.VehicleService":   emergencyServices.Conn,
+		"main.IncidentService":  incidentNotifier.Conn,
+		"main.ApiKeyService":    emergencyServices.Conn,
+	})
+	if err != nil {
+		log.Fatalf("Nie udało się udostępnić metod gRPC przez HTTP/JSON: %v", err)
+	}
 
-	mux.HandleFunc("/vehicle", CreateVehicleHandler)
-	mux.HandleFunc("/vehicle/get", GetVehicleHandler)
-	mux.HandleFunc("/vehicle/update", UpdateVehicleHandler)
-	mux.HandleFunc("/vehicle/delete", DeleteVehicleHandler)
+	mux.HandleFunc("GET /openapi.json", OpenAPIHandler)
+	mux.HandleFunc("GET /docs", OpenAPIDocsHandler)
+	mux.HandleFunc("GET /docs/{asset}", SwaggerUIAssetHandler)
+	mux.HandleFunc("GET /healthz", LivenessHandler)
+	mux.HandleFunc("GET /readyz", ReadinessHandler([]*Backend{emergencyServices, incidentNotifier}))
 
 	fmt.Println("Serwer obsługujący zapytania klienta nasłuchuje na adresie http://localhost:8080")
-	if err := http.ListenAndServe(":8080", mux); err != nil {
+	if err := http.ListenAndServe(":8080", withRequestID(withAuthentication(tokenSigner, apiKeys, mux))); err != nil {
 		log.Fatalf("Nie udało się uruchomić serwera http: %v", err)
 	}
 }
@@ -0,0 +1,558 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+//go:embed openapi-docs.html
+var openAPIDocsPage []byte
+
+// swagger-ui-dist 4.15.5, served by client-handler itself so the page does not depend on a CDN.
+//
+//go:embed swagger-ui/swagger-ui.css swagger-ui/swagger-ui-bundle.js
+var swaggerUIAssets embed.FS
+
+// apiOperation describes one REST endpoint. The document served at /openapi.json is built from these
+// descriptions and from the Go types the handlers decode and encode, so the schemas follow the struct tags.
+type apiOperation struct {
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+	Tag         string
+	Deprecated  bool
+	Parameters  []apiParameter
+	Request     interface{}
+	Status      int
+	Response    interface{}
+	MediaType   string
+	Headers     map[string]string
+	Errors      []int
+}
+
+type apiParameter struct {
+	Name        string
+	In          string
+	Type        string
+	Description string
+	Required    bool
+	Enum        []string
+}
+
+var legacyIDParameter = apiParameter{Name: "id", In: "query", Type: "integer", Required: true}
+
+// Known values of fields which also accept free text from older clients.
+var schemaFieldDescriptions = map[string]string{
+	"Lifeguard.specialization":      "Specjalizacja: " + strings.Join(enumNames(specializationPrefix, Specialization_value), ", ") + " lub dowolny tekst starszych klientów",
+	"Vehicle.type":                  "Typ pojazdu: " + strings.Join(enumNames(vehicleTypePrefix, VehicleType_value), ", ") + " lub dowolny tekst starszych klientów",
+	"LifeguardInput.specialization": "Specjalizacja: " + strings.Join(enumNames(specializationPrefix, Specialization_value), ", ") + " lub dowolny tekst starszych klientów",
+	"LifeguardInput.password_hash":  "Zapisywany przy tworzeniu i aktualizacji, nigdy nie jest zwracany",
+	"Problem.detail":                "Komunikat serwera, który zgłosił błąd",
+	"Problem.message":               "Opis błędu w języku wybranym nagłówkiem Accept-Language (pl lub en)",
+}
+
+var apiOperations = []apiOperation{
+	{
+		Method: http.MethodGet, Path: "/incidents/stream", OperationID: "streamIncidents", Tag: "incidents",
+		Summary: "Strumień zmian incydentów (Server-Sent Events)",
+		Parameters: []apiParameter{
+			{Name: "type", In: "query", Type: "string", Enum: enumNames(incidentChangeTypePrefix, IncidentChangeType_value)},
+			{Name: "status", In: "query", Type: "string", Enum: enumNames(incidentStatusPrefix, IncidentStatus_value)},
+			{Name: "severity", In: "query", Type: "string", Enum: enumNames(incidentSeverityPrefix, IncidentSeverity_value)},
+			{Name: "zone", In: "query", Type: "string"},
+			{Name: "lastEventId", In: "query", Type: "string", Description: "Wznawia strumień po zdarzeniu o tym ID (postać <uruchomienie>-<numer>), zamiennik nagłówka Last-Event-ID. Jeśli część zdarzeń mogła zostać pominięta, strumień zaczyna się od zdarzenia reset"},
+			{Name: "Last-Event-ID", In: "header", Type: "string"},
+			{Name: "access_token", In: "query", Type: "string", Description: "Token dostępu dla klientów EventSource, które nie mogą wysłać nagłówka Authorization"},
+		},
+		Status: http.StatusOK, Response: "", MediaType: "text/event-stream",
+		Errors: []int{http.StatusBadRequest},
+	},
+
+	{
+		Method: http.MethodPost, Path: "/lifeguard", OperationID: "legacyCreateLifeguard", Tag: "deprecated",
+		Summary: "Tworzy ratownika, zastąpione przez POST /v2/lifeguards", Deprecated: true,
+		Request: LifeguardInput{}, Status: http.StatusCreated, Response: CreateLifeguardResponse{},
+		Errors: []int{http.StatusBadRequest},
+	},
+	{
+		Method: http.MethodGet, Path: "/lifeguard/get", OperationID: "legacyGetLifeguard", Tag: "deprecated",
+		Summary: "Pobiera ratownika, zastąpione przez GET /v2/lifeguards/{id}", Deprecated: true,
+		Parameters: []apiParameter{legacyIDParameter},
+		Status:     http.StatusOK, Response: Lifeguard{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	{
+		Method: http.MethodPut, Path: "/lifeguard/update", OperationID: "legacyUpdateLifeguard", Tag: "deprecated",
+		Summary: "Zastępuje wszystkie pola ratownika, zastąpione przez PUT /v2/lifeguards/{id}", Deprecated: true,
+		Parameters: []apiParameter{legacyIDParameter},
+		Request:    LifeguardInput{}, Status: http.StatusOK, Response: UpdateLifeguardResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	{
+		Method: http.MethodDelete, Path: "/lifeguard/delete", OperationID: "legacyDeleteLifeguard", Tag: "deprecated",
+		Summary: "Usuwa ratownika, zastąpione przez DELETE /v2/lifeguards/{id}", Deprecated: true,
+		Parameters: []apiParameter{legacyIDParameter},
+		Status:     http.StatusNoContent,
+		Errors:     []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	{
+		Method: http.MethodPost, Path: "/vehicle", OperationID: "legacyCreateVehicle", Tag: "deprecated",
+		Summary: "Tworzy pojazd, zastąpione przez POST /v2/vehicles", Deprecated: true,
+		Request: Vehicle{}, Status: http.StatusCreated, Response: CreateVehicleResponse{},
+		Errors: []int{http.StatusBadRequest},
+	},
+	{
+		Method: http.MethodGet, Path: "/vehicle/get", OperationID: "legacyGetVehicle", Tag: "deprecated",
+		Summary: "Pobiera pojazd, zastąpione przez GET /v2/vehicles/{id}", Deprecated: true,
+		Parameters: []apiParameter{legacyIDParameter},
+		Status:     http.StatusOK, Response: Vehicle{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	{
+		Method: http.MethodPut, Path: "/vehicle/update", OperationID: "legacyUpdateVehicle", Tag: "deprecated",
+		Summary: "Zastępuje wszystkie pola pojazdu, zastąpione przez PUT /v2/vehicles/{id}", Deprecated: true,
+		Parameters: []apiParameter{legacyIDParameter},
+		Request:    Vehicle{}, Status: http.StatusOK, Response: UpdateVehicleResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	{
+		Method: http.MethodDelete, Path: "/vehicle/delete", OperationID: "legacyDeleteVehicle", Tag: "deprecated",
+		Summary: "Usuwa pojazd, zastąpione przez DELETE /v2/vehicles/{id}", Deprecated: true,
+		Parameters: []apiParameter{legacyIDParameter},
+		Status:     http.StatusNoContent,
+		Errors:     []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+}
+
+var (
+	openAPIOnce     sync.Once
+	openAPIDocument []byte
+)
+
+func OpenAPIHandler(w http.ResponseWriter, r *http.Request) {
+	openAPIOnce.Do(func() {
+		document, err := buildOpenAPI()
+		if err == nil {
+			openAPIDocument, err = json.MarshalIndent(document, "", "  ")
+		}
+		if err != nil {
+			log.Printf("Nie udało się zbudować dokumentu OpenAPI: %v\n", err)
+		}
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPIDocument)
+}
+
+func OpenAPIDocsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(openAPIDocsPage)
+}
+
+func SwaggerUIAssetHandler(w http.ResponseWriter, r *http.Request) {
+	http.ServeFileFS(w, r, swaggerUIAssets, "swagger-ui/"+r.PathValue("asset"))
+}
+
+func buildOpenAPI() (map[string]interface{}, error) {
+	schemas := map[string]interface{}{}
+	paths := map[string]map[string]interface{}{}
+	tags := []map[string]string{
+		{"name": "incidents", "description": "Incydenty"},
+		{"name": "deprecated", "description": "Przestarzałe ścieżki zachowane dla starszych klientów"},
+	}
+
+	for _, operation := range apiOperations {
+		if paths[operation.Path] == nil {
+			paths[operation.Path] = map[string]interface{}{}
+		}
+		paths[operation.Path][strings.ToLower(operation.Method)] = operation.document(schemas)
+	}
+
+	routes, err := transcodedRoutes(transcodedServices())
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routes {
+		if paths[route.path] == nil {
+			paths[route.path] = map[string]interface{}{}
+		}
+		paths[route.path][strings.ToLower(route.method)] = route.document(schemas)
+	}
+	for _, service := range transcodedServices() {
+		tags = append(tags, map[string]string{
+			"name":        string(service.Name()),
+			"description": fmt.Sprintf("Metody %s udostępnione przez HTTP/JSON, nazwy pól według mapowania JSON Protocol Buffers", service.FullName()),
+		})
+	}
+
+	schemaFor(reflect.TypeOf(Problem{}), schemas)
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "client-handler REST API",
+			"version":     "1.0.0",
+			"description": "Ratownicy, pojazdy i strumień incydentów. Błędy są zwracane jako application/problem+json (RFC 9457).",
+		},
+		"servers":  []map[string]string{{"url": "http://localhost:8080"}},
+		"tags":     tags,
+		"paths":    paths,
+		"security": []map[string][]string{{"bearerAuth": {}}, {"apiKeyAuth": {}}},
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"bearerAuth": map[string]string{
+					"type":         "http",
+					"scheme":       "bearer",
+					"bearerFormat": "JWT",
+					"description":  "Token podpisany sekretem AUTH_TOKEN_SECRET, rola w polu role określa dostępne operacje",
+				},
+				"apiKeyAuth": map[string]string{
+					"type":        "apiKey",
+					"in":          "header",
+					"name":        apiKeyHeader,
+					"description": "Klucz API klienta maszynowego, utworzony przez POST /v2/api-keys",
+				},
+			},
+			"responses": map[string]interface{}{
+				"Problem": map[string]interface{}{
+					"description": "Błąd opisany w formacie RFC 9457, kod HTTP wynika z kodu gRPC zwróconego przez serwer",
+					"headers":     map[string]interface{}{requestIDHeader: map[string]string{"$ref": "#/components/headers/" + requestIDHeader}},
+					"content": map[string]interface{}{
+						problemMediaType: map[string]interface{}{"schema": map[string]string{"$ref": "#/components/schemas/Problem"}},
+					},
+				},
+			},
+			"headers": map[string]interface{}{
+				requestIDHeader: map[string]interface{}{
+					"description": "Identyfikator zapytania, ten sam co request_id w treści błędu",
+					"schema":      map[string]string{"type": "string"},
+				},
+			},
+		},
+	}, nil
+}
+
+func (o apiOperation) document(schemas map[string]interface{}) map[string]interface{} {
+	document := map[string]interface{}{
+		"operationId": o.OperationID,
+		"summary":     o.Summary,
+		"tags":        []string{o.Tag},
+	}
+	if o.Deprecated {
+		document["deprecated"] = true
+	}
+
+	if len(o.Parameters) > 0 {
+		parameters := make([]map[string]interface{}, 0, len(o.Parameters))
+		for _, parameter := range o.Parameters {
+			schema := map[string]interface{}{"type": parameter.Type}
+			if parameter.Type == "integer" {
+				schema["format"] = "int64"
+			}
+			if len(parameter.Enum) > 0 {
+				schema["enum"] = parameter.Enum
+			}
+			parameterDocument := map[string]interface{}{
+				"name":     parameter.Name,
+				"in":       parameter.In,
+				"required": parameter.Required,
+				"schema":   schema,
+			}
+			if parameter.Description != "" {
+				parameterDocument["description"] = parameter.Description
+			}
+			parameters = append(parameters, parameterDocument)
+		}
+		document["parameters"] = parameters
+	}
+
+	if o.Request != nil {
+		document["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{"schema": schemaFor(reflect.TypeOf(o.Request), schemas)},
+			},
+		}
+	}
+
+	headers := map[string]interface{}{
+		requestIDHeader: map[string]string{"$ref": "#/components/headers/" + requestIDHeader},
+	}
+	if o.Deprecated {
+		headers["Deprecation"] = map[string]interface{}{
+			"description": "Data wycofania jako liczba sekund od początku epoki Unix poprzedzona znakiem @ (RFC 9745)",
+			"schema":      map[string]string{"type": "string"},
+		}
+		headers["Link"] = map[string]interface{}{
+			"description": "Następca endpointu z relacją successor-version",
+			"schema":      map[string]string{"type": "string"},
+		}
+	}
+	for name, description := range o.Headers {
+		headers[name] = map[string]interface{}{"description": description, "schema": map[string]string{"type": "string"}}
+	}
+
+	success := map[string]interface{}{
+		"description": http.StatusText(o.Status),
+		"headers":     headers,
+	}
+	if o.Response != nil {
+		mediaType := o.MediaType
+		if mediaType == "" {
+			mediaType = "application/json"
+		}
+		success["content"] = map[string]interface{}{
+			mediaType: map[string]interface{}{"schema": schemaFor(reflect.TypeOf(o.Response), schemas)},
+		}
+	}
+
+	responses := map[string]interface{}{
+		strconv.Itoa(o.Status): success,
+	}
+	addProblemResponses(responses, o.Errors)
+	document["responses"] = responses
+
+	return document
+}
+
+// schemaFor describes a Go type the way encoding/json encodes it. Named structs become shared components.
+func schemaFor(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return schemaFor(t.Elem(), schemas)
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": schemaFor(t.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": schemaFor(t.Elem(), schemas)}
+	case reflect.Struct:
+		reference := map[string]interface{}{"$ref": "#/components/schemas/" + t.Name()}
+		if _, ok := schemas[t.Name()]; ok {
+			return reference
+		}
+		schemas[t.Name()] = nil
+
+		properties := map[string]interface{}{}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if !field.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+
+			property := schemaFor(field.Type, schemas)
+			if description, ok := schemaFieldDescriptions[t.Name()+"."+name]; ok {
+				property["description"] = description
+			}
+			properties[name] = property
+		}
+		schemas[t.Name()] = map[string]interface{}{
+			"type":       "object",
+			"properties": properties,
+		}
+		return reference
+	}
+	return map[string]interface{}{}
+}
+
+// enumNames lists the values of a proto enum without the prefix and the unspecified value, in declaration order.
+func enumNames(prefix string, values map[string]int32) []string {
+	names := make([]string, 0, len(values))
+	for name, number := range values {
+		if number != 0 {
+			names = append(names, strings.TrimPrefix(name, prefix))
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return values[prefix+names[i]] < values[prefix+names[j]]
+	})
+	return names
+}
+
+// Every endpoint requires a token and calls a backend, so it can also fail with the statuses of a rejected token,
+// a missing role and an unavailable or slow service.
+func addProblemResponses(responses map[string]interface{}, statuses []int) {
+	for _, status := range append(statuses, http.StatusUnauthorized, http.StatusForbidden, http.StatusMethodNotAllowed, http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusGatewayTimeout) {
+		responses[strconv.Itoa(status)] = map[string]string{"$ref": "#/components/responses/Problem"}
+	}
+}
+
+func (route transcodedRoute) document(schemas map[string]interface{}) map[string]interface{} {
+	service := route.rpc.Parent().(protoreflect.ServiceDescriptor)
+	document := map[string]interface{}{
+		"operationId": fmt.Sprintf("%s_%s", service.Name(), route.rpc.Name()),
+		"summary":     fmt.Sprintf("%s.%s", service.Name(), route.rpc.Name()),
+		"tags":        []string{string(service.Name())},
+	}
+
+	input := route.rpc.Input()
+	parameters := []map[string]interface{}{}
+	for _, field := range route.pathFields {
+		parameters = append(parameters, map[string]interface{}{
+			"name":     string(field.Name()),
+			"in":       "path",
+			"required": true,
+			"schema":   fieldSchema(field, schemas),
+		})
+	}
+	headers := make([]string, 0, len(route.headerFields))
+	for header := range route.headerFields {
+		headers = append(headers, header)
+	}
+	sort.Strings(headers)
+	for _, header := range headers {
+		parameters = append(parameters, map[string]interface{}{
+			"name":        header,
+			"in":          "header",
+			"description": fmt.Sprintf("Zamiennik pola %s treści zapytania", route.headerFields[header].JSONName()),
+			"schema":      fieldSchema(route.headerFields[header], schemas),
+		})
+	}
+	if route.body != "*" {
+		fields := input.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			if route.isPathField(field) || string(field.Name()) == route.body || field.Message() != nil || field.IsMap() {
+				continue
+			}
+			parameters = append(parameters, map[string]interface{}{
+				"name":   field.JSONName(),
+				"in":     "query",
+				"schema": fieldSchema(field, schemas),
+			})
+		}
+	}
+	if len(parameters) > 0 {
+		document["parameters"] = parameters
+	}
+
+	switch route.body {
+	case "":
+	case "*":
+		document["requestBody"] = map[string]interface{}{"required": true, "content": jsonContent(messageSchema(input, schemas))}
+	default:
+		document["requestBody"] = map[string]interface{}{"required": true, "content": jsonContent(fieldSchema(input.Fields().ByName(protoreflect.Name(route.body)), schemas))}
+	}
+
+	response := messageSchema(route.rpc.Output(), schemas)
+	if route.responseBody != "" {
+		response = fieldSchema(route.rpc.Output().Fields().ByName(protoreflect.Name(route.responseBody)), schemas)
+	}
+	responseHeaders := map[string]interface{}{requestIDHeader: map[string]string{"$ref": "#/components/headers/" + requestIDHeader}}
+	if route.location != "" {
+		responseHeaders["Location"] = map[string]interface{}{
+			"description": "Adres utworzonego zasobu, " + route.location,
+			"schema":      map[string]string{"type": "string"},
+		}
+	}
+	success := map[string]interface{}{
+		"description": http.StatusText(route.status),
+		"headers":     responseHeaders,
+	}
+	if route.status != http.StatusNoContent {
+		success["content"] = jsonContent(response)
+	}
+	responses := map[string]interface{}{strconv.Itoa(route.status): success}
+	addProblemResponses(responses, []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict})
+	document["responses"] = responses
+
+	return document
+}
+
+func jsonContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema},
+	}
+}
+
+// messageSchema describes a message in the proto JSON mapping. Components are named with the full proto name,
+// so they do not collide with the schemas of Go types.
+func messageSchema(message protoreflect.MessageDescriptor, schemas map[string]interface{}) map[string]interface{} {
+	name := string(message.FullName())
+	reference := map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	if _, ok := schemas[name]; ok {
+		return reference
+	}
+	schemas[name] = nil
+
+	properties := map[string]interface{}{}
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		property := fieldSchema(field, schemas)
+		if field.Options().(*descriptorpb.FieldOptions).GetDeprecated() {
+			property["deprecated"] = true
+		}
+		properties[field.JSONName()] = property
+	}
+	schemas[name] = map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	return reference
+}
+
+func fieldSchema(field protoreflect.FieldDescriptor, schemas map[string]interface{}) map[string]interface{} {
+	if field.IsMap() {
+		return map[string]interface{}{"type": "object", "additionalProperties": singularFieldSchema(field.MapValue(), schemas)}
+	}
+	if field.IsList() {
+		return map[string]interface{}{"type": "array", "items": singularFieldSchema(field, schemas)}
+	}
+	return singularFieldSchema(field, schemas)
+}
+
+func singularFieldSchema(field protoreflect.FieldDescriptor, schemas map[string]interface{}) map[string]interface{} {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return map[string]interface{}{"type": "string"}
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	// The proto JSON mapping writes 64-bit integers as strings.
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return map[string]interface{}{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageSchema(field.Message(), schemas)
+	}
+	return map[string]interface{}{}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var updateOpenAPI = flag.Bool("update-openapi", false, "zapisuje wygenerowany dokument do openapi.json")
+
+type recordingMux struct {
+	patterns []string
+}
+
+func (m *recordingMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	// Catch-all patterns without a method answer 405 or, for the deprecated aliases, repeat a documented route.
+	if strings.Contains(pattern, " ") {
+		m.patterns = append(m.patterns, pattern)
+	}
+}
+
+func TestOpenAPIDescribesEveryRoute(t *testing.T) {
+	mux := &recordingMux{}
+	registerRestRoutes(mux)
+
+	registered := map[string]bool{}
+	for _, pattern := range mux.patterns {
+		registered[pattern] = true
+	}
+	documented := map[string]bool{}
+	for _, operation := range apiOperations {
+		documented[operation.Method+" "+operation.Path] = true
+	}
+
+	var missing, stale []string
+	for pattern := range registered {
+		if !documented[pattern] {
+			missing = append(missing, pattern)
+		}
+	}
+	for pattern := range documented {
+		if !registered[pattern] {
+			stale = append(stale, pattern)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(stale)
+
+	if len(missing) > 0 {
+		t.Errorf("Endpointy bez opisu w apiOperations: %v", missing)
+	}
+	if len(stale) > 0 {
+		t.Errorf("Opisy w apiOperations bez zarejestrowanego endpointu: %v", stale)
+	}
+}
+
+// The committed openapi.json is what frontend developers read, so a change of a handler type or route
+// has to come with a regenerated document: go test -run TestOpenAPIDocumentIsUpToDate -update-openapi
+func TestOpenAPIDocumentIsUpToDate(t *testing.T) {
+	document, err := buildOpenAPI()
+	if err != nil {
+		t.Fatalf("Nie udało się zbudować dokumentu OpenAPI: %v", err)
+	}
+	generated, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		t.Fatalf("Nie udało się zbudować dokumentu OpenAPI: %v", err)
+	}
+	generated = append(generated, '\n')
+
+	if *updateOpenAPI {
+		if err := os.WriteFile("openapi.json", generated, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	committed, err := os.ReadFile("openapi.json")
+	if err != nil {
+		t.Fatalf("Brak pliku openapi.json: %v", err)
+	}
+	if !bytes.Equal(committed, generated) {
+		t.Errorf("openapi.json nie odpowiada handlerom, uruchom: go test -run TestOpenAPIDocumentIsUpToDate -update-openapi")
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	persistedQueriesAuto      = "auto"
+	persistedQueriesAllowList = "allowlist"
+
+	maxPersistedQueries = 1000
+)
+
+// PersistedQueryStore implements automatic persisted queries: a client sends the sha256 hash of a query
+// in extensions.persistedQuery and the full query only when the server does not know the hash yet.
+// In allow-list mode only the queries loaded at startup can be executed and nothing new is registered.
+type PersistedQueryStore struct {
+	mode string
+
+	mu      sync.RWMutex
+	queries map[string]string
+	order   []string
+}
+
+var persistedQueries = NewPersistedQueryStore(persistedQueriesAuto, nil)
+
+func NewPersistedQueryStore(mode string, known map[string]string) *PersistedQueryStore {
+	store := &PersistedQueryStore{
+		mode:    mode,
+		queries: map[string]string{},
+	}
+	for hash, query := range known {
+		store.queries[hash] = query
+	}
+	return store
+}
+
+// The mode is set with GRAPHQL_PERSISTED_QUERIES (auto by default or allowlist). GRAPHQL_PERSISTED_QUERIES_FILE
+// points to a JSON object mapping sha256 hashes to queries, required in allow-list mode.
+func persistedQueriesFromEnv() (*PersistedQueryStore, error) {
+	mode := strings.ToLower(os.Getenv("GRAPHQL_PERSISTED_QUERIES"))
+	switch mode {
+	case "":
+		mode = persistedQueriesAuto
+	case persistedQueriesAuto, persistedQueriesAllowList:
+	default:
+		return nil, fmt.Errorf("Nieznany tryb zapisanych zapytań: %q", mode)
+	}
+
+	path := os.Getenv("GRAPHQL_PERSISTED_QUERIES_FILE")
+	if path == "" {
+		if mode == persistedQueriesAllowList {
+			return nil, fmt.Errorf("Tryb %s wymaga zmiennej GRAPHQL_PERSISTED_QUERIES_FILE", mode)
+		}
+		return NewPersistedQueryStore(mode, nil), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var known map[string]string
+	if err := json.Unmarshal(data, &known); err != nil {
+		return nil, fmt.Errorf("Niepoprawny format pliku %s: %v", path, err)
+	}
+	// Requests are looked up by lowercase hashes, see persistedQueryHash.
+	normalized := make(map[string]string, len(known))
+	for hash, query := range known {
+		if queryHash(query) != strings.ToLower(hash) {
+			return nil, fmt.Errorf("Skrót %s w pliku %s nie odpowiada treści zapytania", hash, path)
+		}
+		normalized[strings.ToLower(hash)] = query
+	}
+
+	return NewPersistedQueryStore(mode, normalized), nil
+}
+
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+func persistedQueryHash(request graphqlRequest) string {
+	persistedQuery, ok := request.Extensions["persistedQuery"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	hash, _ := persistedQuery["sha256Hash"].(string)
+	return strings.ToLower(hash)
+}
+
+// Resolve fills in the query of a request that carries only its hash and registers new queries.
+func (s *PersistedQueryStore) Resolve(request *graphqlRequest) error {
+	hash := persistedQueryHash(*request)
+
+	if hash == "" {
+		if s.mode == persistedQueriesAllowList && request.Query != "" && !s.known(queryHash(request.Query)) {
+			return errQueryNotAllowed()
+		}
+		return nil
+	}
+
+	if request.Query == "" {
+		s.mu.RLock()
+		query, ok := s.queries[hash]
+		s.mu.RUnlock()
+		if ok {
+			request.Query = query
+			return nil
+		}
+		if s.mode == persistedQueriesAllowList {
+			return errQueryNotAllowed()
+		}
+		return &graphqlRequestError{
+			status:  http.StatusOK,
+			code:    "PERSISTED_QUERY_NOT_FOUND",
+			message: "PersistedQueryNotFound",
+		}
+	}
+
+	if queryHash(request.Query) != hash {
+		return &graphqlRequestError{
+			status:  http.StatusBadRequest,
+			code:    "PERSISTED_QUERY_HASH_MISMATCH",
+			message: "Skrót sha256Hash nie odpowiada treści zapytania",
+		}
+	}
+	if s.mode == persistedQueriesAllowList {
+		if !s.known(hash) {
+			return errQueryNotAllowed()
+		}
+		return nil
+	}
+
+	s.register(hash, request.Query)
+	return nil
+}
+
+func (s *PersistedQueryStore) known(hash string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.queries[hash]
+	return ok
+}
+
+// The oldest registered queries are forgotten first; clients then simply send them again.
+func (s *PersistedQueryStore) register(hash, query string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.queries[hash]; ok {
+		return
+	}
+	if len(s.order) >= maxPersistedQueries {
+		delete(s.queries, s.order[0])
+		s.order = s.order[1:]
+	}
+	s.queries[hash] = query
+	s.order = append(s.order, hash)
+}
+
+func errQueryNotAllowed() error {
+	return &graphqlRequestError{
+		status:  http.StatusForbidden,
+		code:    "PERSISTED_QUERY_NOT_ALLOWED",
+		message: "Zapytanie nie znajduje się na liście dozwolonych operacji",
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/connectivity"
+)
+
+type BackendHealth struct {
+	Address    string `json:"address"`
+	Connection string `json:"connection"`
+	Circuit    string `json:"circuit"`
+	Ready      bool   `json:"ready"`
+}
+
+type Readiness struct {
+	Status   string                   `json:"status"`
+	Backends map[string]BackendHealth `json:"backends"`
+}
+
+func (b *Backend) health() BackendHealth {
+	state := b.Conn.GetState()
+	if state == connectivity.Idle {
+		// The check itself wakes the connection up, so that a backend that went away is noticed without waiting for a call.
+		b.Conn.Connect()
+	}
+	circuit := b.breaker.current()
+	return BackendHealth{
+		Address:    b.Address,
+		Connection: strings.ToLower(state.String()),
+		Circuit:    circuit.String(),
+		// An idle connection counts only if it was closed for lack of calls, not after a failed attempt.
+		Ready: (state == connectivity.Ready || state == connectivity.Idle && b.connected.Load()) && circuit != circuitOpen,
+	}
+}
+
+// LivenessHandler answers as long as the process serves HTTP.
+func LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
+
+// ReadinessHandler reports the state of the connection and circuit of every backend, with 503 when
+// any of them cannot serve requests.
+func ReadinessHandler(backends []*Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		readiness := Readiness{Status: "ready", Backends: map[string]BackendHealth{}}
+		httpStatus := http.StatusOK
+		for _, backend := range backends {
+			health := backend.health()
+			if !health.Ready {
+				readiness.Status = "unavailable"
+				httpStatus = http.StatusServiceUnavailable
+			}
+			readiness.Backends[backend.Name] = health
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(httpStatus)
+		json.NewEncoder(w).Encode(readiness)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The original lifeguard and vehicle paths were deprecated when the /v2 routes were introduced. No sunset date
+// has been set yet, so responses carry no Sunset header.
+var legacyPathsDeprecatedAt = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+// routeRegistrar is satisfied by *http.ServeMux, the OpenAPI test uses it to list the registered routes.
+type routeRegistrar interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+}
+
+// registerRestRoutes registers the resource-oriented API. Every resource path also gets a catch-all handler,
+// so that unsupported methods are answered with 405 and a JSON body instead of the plain text of ServeMux.
+// Routes added here have to be described in apiOperations as well.
+func registerRestRoutes(mux routeRegistrar) {
+	restResource(mux, "/incidents/stream", map[string]http.HandlerFunc{
+		http.MethodGet: IncidentStreamHandler,
+	})
+
+	// The original paths stay available until clients move to the /v2 routes transcoded from the protos.
+	deprecatedAlias(mux, "/lifeguard", http.MethodPost, "/v2/lifeguards", CreateLifeguardHandler)
+	deprecatedAlias(mux, "/lifeguard/get", http.MethodGet, "/v2/lifeguards/{id}", GetLifeguardHandler)
+	deprecatedAlias(mux, "/lifeguard/update", http.MethodPut, "/v2/lifeguards/{id}", UpdateLifeguardHandler)
+	deprecatedAlias(mux, "/lifeguard/delete", http.MethodDelete, "/v2/lifeguards/{id}", DeleteLifeguardHandler)
+
+	deprecatedAlias(mux, "/vehicle", http.MethodPost, "/v2/vehicles", CreateVehicleHandler)
+	deprecatedAlias(mux, "/vehicle/get", http.MethodGet, "/v2/vehicles/{id}", GetVehicleHandler)
+	deprecatedAlias(mux, "/vehicle/update", http.MethodPut, "/v2/vehicles/{id}", UpdateVehicleHandler)
+	deprecatedAlias(mux, "/vehicle/delete", http.MethodDelete, "/v2/vehicles/{id}", DeleteVehicleHandler)
+}
+
+func restResource(mux routeRegistrar, path string, handlers map[string]http.HandlerFunc) {
+	methods := make([]string, 0, len(handlers))
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		handler, ok := handlers[method]
+		if !ok {
+			continue
+		}
+		mux.HandleFunc(method+" "+path, handler)
+		methods = append(methods, method)
+	}
+	mux.HandleFunc(path, methodNotAllowedHandler(methods...))
+}
+
+// The alias marks every response as deprecated since legacyPathsDeprecatedAt (RFC 9745). The original paths accepted any method and older
+// clients rely on that, so the catch-all serves the handler too; only the documented method is registered
+// on its own, for the API description.
+func deprecatedAlias(mux routeRegistrar, path, method, successor string, handler http.HandlerFunc) {
+	deprecated := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "@"+strconv.FormatInt(legacyPathsDeprecatedAt.Unix(), 10))
+		w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", successor))
+		handler(w, r)
+	}
+	mux.HandleFunc(method+" "+path, deprecated)
+	mux.HandleFunc(path, deprecated)
+}
+
+func methodNotAllowedHandler(methods ...string) http.HandlerFunc {
+	allow := append([]string{}, methods...)
+	for _, method := range methods {
+		if method == http.MethodGet {
+			allow = append(allow, http.MethodHead)
+			break
+		}
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", strings.Join(allow, ", "))
+		writeJSONError(w, r, http.StatusMethodNotAllowed, fmt.Sprintf("Metoda %s niedozwolona, dostępne: %s", r.Method, strings.Join(allow, ", ")))
+	}
+}
@@ -0,0 +1,451 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const maxTranscodedBodySize = 1 << 20
+
+// transcodedServices lists the services exposed over HTTP/JSON according to the google.api.http annotations
+// of their methods. The file descriptors are set by the init functions of the generated code, hence a function.
+func transcodedServices() []protoreflect.ServiceDescriptor {
+	return []protoreflect.ServiceDescriptor{
+		File_lifeguard_proto.Services().ByName("LifeguardService"),
+		File_vehicle_proto.Services().ByName("VehicleService"),
+		File_incident_proto.Services().ByName("IncidentService"),
+		File_api_key_proto.Services().ByName("ApiKeyService"),
+	}
+}
+
+// Fields never returned over HTTP, like in the hand-written REST API and GraphQL.
+var transcodedHiddenFields = map[protoreflect.FullName]bool{
+	"main.GetLifeguardResponse.password_hash": true,
+}
+
+// Request headers copied into a field of the request when the body leaves it empty, for clients following
+// the usual HTTP conventions.
+var transcodedHeaderFields = map[protoreflect.FullName]map[string]protoreflect.Name{
+	"main.IncidentService.CreateIncident": {"Idempotency-Key": "idempotency_key"},
+}
+
+// transcodedResponse sets the status of a successful response instead of 200 and, for created resources,
+// the Location header. Variables of the location template name fields of the response message.
+type transcodedResponse struct {
+	status   int
+	location string
+}
+
+// Every route answers 200, delete routes 204 without a body, unless the RPC is listed here.
+var transcodedResponses = map[protoreflect.FullName]transcodedResponse{
+	"main.LifeguardService.CreateLifeguard": {status: http.StatusCreated, location: "/v2/lifeguards/{id}"},
+	"main.VehicleService.CreateVehicle":     {status: http.StatusCreated, location: "/v2/vehicles/{id}"},
+	"main.IncidentService.CreateIncident":   {status: http.StatusCreated, location: "/v2/incidents/{incident.incident_id}"},
+	"main.IncidentService.AddIncidentNote":  {status: http.StatusCreated},
+	"main.ApiKeyService.CreateApiKey":       {status: http.StatusCreated},
+}
+
+var pathVariable = regexp.MustCompile(`\{([^}]*)\}`)
+
+// transcodedRoute binds one HTTP method and path template to an RPC. Path variables and, for rules without
+// body "*", query parameters fill the request fields which are not taken from the body, headers listed in
+// transcodedHeaderFields the fields named there.
+type transcodedRoute struct {
+	method         string
+	path           string
+	rpc            protoreflect.MethodDescriptor
+	body           string
+	responseBody   string
+	pathFields     []protoreflect.FieldDescriptor
+	headerFields   map[string]protoreflect.FieldDescriptor
+	status         int
+	location       string
+	locationFields map[string][]protoreflect.FieldDescriptor
+}
+
+func (route transcodedRoute) fullMethod() string {
+	return fmt.Sprintf("/%s/%s", route.rpc.Parent().FullName(), route.rpc.Name())
+}
+
+// transcodedRoutes reads the HTTP rules of every method of the services, streaming methods are skipped.
+func transcodedRoutes(services []protoreflect.ServiceDescriptor) ([]transcodedRoute, error) {
+	var routes []transcodedRoute
+	for _, service := range services {
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			method := methods.Get(i)
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				continue
+			}
+			rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+
+			for _, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+				route, err := newTranscodedRoute(method, binding)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v", method.FullName(), err)
+				}
+				routes = append(routes, route)
+			}
+		}
+	}
+	return routes, nil
+}
+
+func newTranscodedRoute(method protoreflect.MethodDescriptor, rule *annotations.HttpRule) (transcodedRoute, error) {
+	route := transcodedRoute{
+		rpc:          method,
+		body:         rule.GetBody(),
+		responseBody: rule.GetResponseBody(),
+	}
+
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		route.method, route.path = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Put:
+		route.method, route.path = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Post:
+		route.method, route.path = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Delete:
+		route.method, route.path = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		route.method, route.path = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		route.method, route.path = strings.ToUpper(pattern.Custom.GetKind()), pattern.Custom.GetPath()
+	default:
+		return route, fmt.Errorf("brak wzorca ścieżki w regule HTTP")
+	}
+
+	fields := method.Input().Fields()
+	// Only single-segment variables naming a top-level field are supported, they map directly onto ServeMux wildcards.
+	for _, match := range pathVariable.FindAllStringSubmatch(route.path, -1) {
+		field := fields.ByName(protoreflect.Name(match[1]))
+		if field == nil || field.IsList() || field.Message() != nil {
+			return route, fmt.Errorf("nieobsługiwana zmienna ścieżki %s", match[0])
+		}
+		route.pathFields = append(route.pathFields, field)
+	}
+	for header, name := range transcodedHeaderFields[method.FullName()] {
+		field := fields.ByName(name)
+		if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+			return route, fmt.Errorf("nieobsługiwane pole nagłówka %s: %s", header, name)
+		}
+		if route.headerFields == nil {
+			route.headerFields = map[string]protoreflect.FieldDescriptor{}
+		}
+		route.headerFields[header] = field
+	}
+	if route.body != "" && route.body != "*" && fields.ByName(protoreflect.Name(route.body)) == nil {
+		return route, fmt.Errorf("pole body %q nie istnieje", route.body)
+	}
+	if route.responseBody != "" && method.Output().Fields().ByName(protoreflect.Name(route.responseBody)) == nil {
+		return route, fmt.Errorf("pole response_body %q nie istnieje", route.responseBody)
+	}
+
+	route.status = http.StatusOK
+	if route.method == http.MethodDelete {
+		route.status = http.StatusNoContent
+	}
+	if response, ok := transcodedResponses[method.FullName()]; ok {
+		route.status, route.location = response.status, response.location
+	}
+	for _, match := range pathVariable.FindAllStringSubmatch(route.location, -1) {
+		fieldPath, err := responseFieldPath(method.Output(), match[1])
+		if err != nil {
+			return route, err
+		}
+		if route.locationFields == nil {
+			route.locationFields = map[string][]protoreflect.FieldDescriptor{}
+		}
+		route.locationFields[match[1]] = fieldPath
+	}
+	return route, nil
+}
+
+// responseFieldPath resolves a dotted field path, e.g. incident.incident_id, to a single value of the message.
+func responseFieldPath(message protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	var fieldPath []protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		if message == nil {
+			return nil, fmt.Errorf("nieobsługiwana zmienna adresu Location {%s}", path)
+		}
+		field := message.Fields().ByName(protoreflect.Name(name))
+		if field == nil || field.IsList() || field.IsMap() {
+			return nil, fmt.Errorf("nieobsługiwana zmienna adresu Location {%s}", path)
+		}
+		fieldPath = append(fieldPath, field)
+		message = field.Message()
+	}
+	if message != nil {
+		return nil, fmt.Errorf("nieobsługiwana zmienna adresu Location {%s}", path)
+	}
+	return fieldPath, nil
+}
+
+// resolveLocation fills the location template with the values of the response.
+func (route transcodedRoute) resolveLocation(resp proto.Message) string {
+	return pathVariable.ReplaceAllStringFunc(route.location, func(variable string) string {
+		message := resp.ProtoReflect()
+		fieldPath := route.locationFields[variable[1:len(variable)-1]]
+		for _, field := range fieldPath[:len(fieldPath)-1] {
+			message = message.Get(field).Message()
+		}
+		return url.PathEscape(message.Get(fieldPath[len(fieldPath)-1]).String())
+	})
+}
+
+// registerTranscodedRoutes exposes the annotated RPCs, each service is called over its own connection.
+func registerTranscodedRoutes(mux routeRegistrar, conns map[protoreflect.FullName]grpc.ClientConnInterface) error {
+	routes, err := transcodedRoutes(transcodedServices())
+	if err != nil {
+		return err
+	}
+
+	handlers := map[string]map[string]http.HandlerFunc{}
+	for _, route := range routes {
+		conn, ok := conns[route.rpc.Parent().FullName()]
+		if !ok {
+			return fmt.Errorf("brak połączenia gRPC dla usługi %s", route.rpc.Parent().FullName())
+		}
+		input, output, err := route.messageTypes()
+		if err != nil {
+			return fmt.Errorf("%s: %v", route.rpc.FullName(), err)
+		}
+		if handlers[route.path] == nil {
+			handlers[route.path] = map[string]http.HandlerFunc{}
+		}
+		handlers[route.path][route.method] = route.handler(conn, input, output)
+	}
+
+	paths := make([]string, 0, len(handlers))
+	for path := range handlers {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		restResource(mux, path, handlers[path])
+	}
+
+	log.Printf("Udostępniono %d metod gRPC przez HTTP/JSON\n", len(routes))
+	return nil
+}
+
+// Messages are created through the global registry, so that the handlers work with the generated Go types.
+func (route transcodedRoute) messageTypes() (input, output protoreflect.MessageType, err error) {
+	input, err = protoregistry.GlobalTypes.FindMessageByName(route.rpc.Input().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	output, err = protoregistry.GlobalTypes.FindMessageByName(route.rpc.Output().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	return input, output, nil
+}
+
+func (route transcodedRoute) handler(conn grpc.ClientConnInterface, input, output protoreflect.MessageType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := input.New().Interface()
+		if err := route.decodeRequest(w, r, req); err != nil {
+			status := http.StatusBadRequest
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				status = http.StatusRequestEntityTooLarge
+			}
+			writeJSONError(w, r, status, err.Error())
+			return
+		}
+
+		resp := output.New().Interface()
+		if err := conn.Invoke(r.Context(), route.fullMethod(), req, resp); err != nil {
+			writeGrpcError(w, r, err)
+			return
+		}
+
+		if route.status == http.StatusNoContent {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if route.location != "" {
+			w.Header().Set("Location", route.resolveLocation(resp))
+		}
+
+		data, err := route.encodeResponse(resp)
+		if err != nil {
+			writeJSONError(w, r, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(route.status)
+		w.Write(data)
+	}
+}
+
+func (route transcodedRoute) decodeRequest(w http.ResponseWriter, r *http.Request, req proto.Message) error {
+	if route.body != "" {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxTranscodedBodySize))
+		if err != nil {
+			return err
+		}
+		if len(strings.TrimSpace(string(body))) > 0 {
+			if route.body != "*" {
+				// The named field is filled by wrapping the body in an object with that one field.
+				field := req.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(route.body))
+				body = []byte(fmt.Sprintf("{%q:%s}", field.JSONName(), body))
+			}
+			if err := protojson.Unmarshal(body, req); err != nil {
+				return fmt.Errorf("Niepoprawna treść zapytania: %v", err)
+			}
+		}
+	}
+
+	message := req.ProtoReflect()
+	for header, field := range route.headerFields {
+		if value := r.Header.Get(header); value != "" && !message.Has(field) {
+			message.Set(field, protoreflect.ValueOfString(value))
+		}
+	}
+	for _, field := range route.pathFields {
+		if err := setFieldFromString(message, field, r.PathValue(string(field.Name()))); err != nil {
+			return err
+		}
+	}
+
+	if route.body == "*" {
+		return nil
+	}
+	fields := message.Descriptor().Fields()
+	for name, values := range r.URL.Query() {
+		field := fields.ByJSONName(name)
+		if field == nil {
+			field = fields.ByName(protoreflect.Name(name))
+		}
+		if field == nil || route.isPathField(field) || string(field.Name()) == route.body {
+			continue
+		}
+		for _, value := range values {
+			if err := setFieldFromString(message, field, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (route transcodedRoute) isPathField(field protoreflect.FieldDescriptor) bool {
+	for _, pathField := range route.pathFields {
+		if pathField == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Unpopulated fields are emitted, so that every response of a method has the same shape.
+func (route transcodedRoute) encodeResponse(resp proto.Message) ([]byte, error) {
+	clearHiddenFields(resp.ProtoReflect())
+
+	options := protojson.MarshalOptions{EmitUnpopulated: true}
+	if route.responseBody == "" {
+		return options.Marshal(resp)
+	}
+
+	data, err := options.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	field := resp.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(route.responseBody))
+	return fields[field.JSONName()], nil
+}
+
+func clearHiddenFields(message protoreflect.Message) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case transcodedHiddenFields[field.FullName()]:
+			message.Clear(field)
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				clearHiddenFields(list.Get(i).Message())
+			}
+		case !field.IsList() && !field.IsMap() && field.Message() != nil:
+			clearHiddenFields(value.Message())
+		}
+		return true
+	})
+}
+
+func setFieldFromString(message protoreflect.Message, field protoreflect.FieldDescriptor, value string) error {
+	fieldValue, err := scalarFromString(field, value)
+	if err != nil {
+		return fmt.Errorf("Niepoprawna wartość parametru %s: %q", field.JSONName(), value)
+	}
+	if field.IsList() {
+		message.Mutable(field).List().Append(fieldValue)
+		return nil
+	}
+	message.Set(field, fieldValue)
+	return nil
+}
+
+func scalarFromString(field protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(value)
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByName(protoreflect.Name(value)); enumValue != nil {
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		}
+		n, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), err
+	}
+	return protoreflect.Value{}, fmt.Errorf("nieobsługiwany typ pola %s", field.Kind())
+}
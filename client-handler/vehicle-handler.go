@@ -1,20 +1,20 @@
 package main
 
 import (
-	"context"
 	"encoding/json"
 	"log"
 	"net/http"
 	"strconv"
-	"time"
 )
 
 type Vehicle struct {
+	Id                  int64  `json:"id,omitempty"`
 	Type                string `json:"type"`
 	Location            string `json:"location"`
 	FuelLevelInLiters   int32  `json:"fuel_level_in_liters"`
 	OnMission           bool   `json:"on_mission"`
 	LifeguardInChargeId int64  `json:"lifeguard_in_charge_id"`
+	CreatedAt           string `json:"created_at,omitempty"`
 }
 
 var vehicleClient VehicleServiceClient
@@ -23,22 +23,22 @@ func CreateVehicleHandler(w http.ResponseWriter, r *http.Request) {
 	var vehicle Vehicle
 	err := json.NewDecoder(r.Body).Decode(&vehicle)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
+	vehicleType, legacyType := vehicleTypeFromString(vehicle.Type)
 
-	vehicleResponse, err := vehicleClient.CreateVehicle(ctx, &CreateVehicleRequest{
-		Type:                vehicle.Type,
+	vehicleResponse, err := vehicleClient.CreateVehicle(r.Context(), &CreateVehicleRequest{
+		LegacyType:          legacyType,
 		Location:            vehicle.Location,
 		FuelLevelInLiters:   vehicle.FuelLevelInLiters,
 		OnMission:           vehicle.OnMission,
 		LifeguardInChargeId: vehicle.LifeguardInChargeId,
+		Type:                vehicleType,
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGrpcError(w, r, err)
 		return
 	}
 
@@ -53,29 +53,26 @@ func GetVehicleHandler(w http.ResponseWriter, r *http.Request) {
 
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		http.Error(w, "Niepoprawny format id podany przez użytkownika", http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, "Niepoprawny format id podany przez użytkownika")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
-
-	vehicleResponse, err := vehicleClient.GetVehicle(ctx, &GetVehicleRequest{Id: id})
+	vehicleResponse, err := vehicleClient.GetVehicle(r.Context(), &GetVehicleRequest{Id: id})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGrpcError(w, r, err)
 		return
 	}
 
 	log.Printf("Pobrano wiersz z tabeli vehicles, id wiersza: %d\n", id)
 
-	json.NewEncoder(w).Encode(vehicleResponse)
+	json.NewEncoder(w).Encode(vehicleFromResponse(vehicleResponse))
 }
 
 func UpdateVehicleHandler(w http.ResponseWriter, r *http.Request) {
 	var vehicle Vehicle
 	err := json.NewDecoder(r.Body).Decode(&vehicle)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -83,23 +80,23 @@ func UpdateVehicleHandler(w http.ResponseWriter, r *http.Request) {
 
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		http.Error(w, "Niepoprawny format id podany przez użytkownika", http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, "Niepoprawny format id podany przez użytkownika")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
+	vehicleType, legacyType := vehicleTypeFromString(vehicle.Type)
 
-	vehicleResponse, err := vehicleClient.UpdateVehicle(ctx, &UpdateVehicleRequest{
+	vehicleResponse, err := vehicleClient.UpdateVehicle(r.Context(), &UpdateVehicleRequest{
 		Id:                  id,
-		Type:                vehicle.Type,
+		LegacyType:          legacyType,
 		Location:            vehicle.Location,
 		FuelLevelInLiters:   vehicle.FuelLevelInLiters,
 		OnMission:           vehicle.OnMission,
 		LifeguardInChargeId: vehicle.LifeguardInChargeId,
+		Type:                vehicleType,
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGrpcError(w, r, err)
 		return
 	}
 
@@ -113,16 +110,13 @@ func DeleteVehicleHandler(w http.ResponseWriter, r *http.Request) {
 
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		http.Error(w, "Niepoprawny format id podany przez użytkownika", http.StatusBadRequest)
+		writeJSONError(w, r, http.StatusBadRequest, "Niepoprawny format id podany przez użytkownika")
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
-
-	_, err = vehicleClient.DeleteVehicle(ctx, &DeleteVehicleRequest{Id: id})
+	_, err = vehicleClient.DeleteVehicle(r.Context(), &DeleteVehicleRequest{Id: id})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGrpcError(w, r, err)
 		return
 	}
 
@@ -130,3 +124,15 @@ func DeleteVehicleHandler(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func vehicleFromResponse(vehicleResponse *GetVehicleResponse) Vehicle {
+	return Vehicle{
+		Id:                  vehicleResponse.Id,
+		Type:                vehicleTypeToString(vehicleResponse.Type, vehicleResponse.LegacyType),
+		Location:            vehicleResponse.Location,
+		FuelLevelInLiters:   vehicleResponse.FuelLevelInLiters,
+		OnMission:           vehicleResponse.OnMission,
+		LifeguardInChargeId: vehicleResponse.LifeguardInChargeId,
+		CreatedAt:           vehicleResponse.CreatedAt,
+	}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+)
+
+var vehicleType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "Vehicle",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.Int,
+			},
+			"type": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					vehicle, ok := p.Source.(*GetVehicleResponse)
+					if !ok {
+						return nil, nil
+					}
+					return vehicleTypeToString(vehicle.Type, vehicle.LegacyType), nil
+				},
+			},
+			"location": &graphql.Field{
+				Type: graphql.String,
+			},
+			"fuelLevelInLiters": &graphql.Field{
+				Type: graphql.Int,
+			},
+			"onMission": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			"lifeguardInChargeID": &graphql.Field{
+				Type: graphql.Int,
+			},
+			"lifeguardInCharge": &graphql.Field{
+				Type:    lifeguardType,
+				Resolve: resolveLifeguardInCharge,
+			},
+			"createdAt": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	},
+)
+
+func fetchVehicle(ctx context.Context, id int64) (*GetVehicleResponse, error) {
+	vehicle, err := vehicleClient.GetVehicle(ctx, &GetVehicleRequest{Id: id})
+	if err != nil {
+		log.Printf("Nie udało się pobrać pojazdu o id: %d, error: %v\n", id, err)
+		return nil, graphqlServiceError("emergency-services", err)
+	}
+	return vehicle, nil
+}
+
+func resolveLifeguardInCharge(p graphql.ResolveParams) (interface{}, error) {
+	vehicle, ok := p.Source.(*GetVehicleResponse)
+	if !ok || vehicle.LifeguardInChargeId == 0 {
+		return nil, nil
+	}
+	return resolveLoaded("emergency-services", loadersFrom(p.Context).lifeguards.Load(p.Context, vehicle.LifeguardInChargeId)), nil
+}
+
+func resolveAssignedVehicles(p graphql.ResolveParams) (interface{}, error) {
+	incident, ok := p.Source.(*IncidentProto)
+	if !ok {
+		return nil, nil
+	}
+
+	return resolveLoaded("emergency-services", loadersFrom(p.Context).vehicles.LoadMany(p.Context, incident.AssignedVehicleIds)), nil
+}
+
+var vehicleField = &graphql.Field{
+	Type: vehicleType,
+	Args: graphql.FieldConfigArgument{
+		"id": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		id := p.Args["id"].(int)
+		return resolveLoaded("emergency-services", loadersFrom(p.Context).vehicles.Load(p.Context, int64(id))), nil
+	},
+}
+
+var vehiclesField = &graphql.Field{
+	Type: graphql.NewList(graphql.NewNonNull(vehicleType)),
+	Args: graphql.FieldConfigArgument{
+		"lifeguardInChargeID": &graphql.ArgumentConfig{
+			Type: graphql.Int,
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		lifeguardInChargeID, _ := p.Args["lifeguardInChargeID"].(int)
+
+		resp, err := vehicleClient.ListVehicles(p.Context, &ListVehiclesRequest{LifeguardInChargeId: int64(lifeguardInChargeID)})
+		if err != nil {
+			log.Printf("Nie udało się pobrać listy pojazdów, error: %v\n", err)
+			return nil, graphqlServiceError("emergency-services", err)
+		}
+
+		log.Printf("Pobrano %d pojazdów\n", len(resp.Vehicles))
+		return resp.Vehicles, nil
+	},
+}
+
+var createVehicleField = &graphql.Field{
+	Type: vehicleType,
+	Args: graphql.FieldConfigArgument{
+		"type": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"location": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"fuelLevelInLiters": &graphql.ArgumentConfig{
+			Type: graphql.Int,
+		},
+		"onMission": &graphql.ArgumentConfig{
+			Type: graphql.Boolean,
+		},
+		"lifeguardInChargeID": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		vehicleTypeName := p.Args["type"].(string)
+		location, _ := p.Args["location"].(string)
+		fuelLevelInLiters, _ := p.Args["fuelLevelInLiters"].(int)
+		onMission, _ := p.Args["onMission"].(bool)
+		lifeguardInChargeID := p.Args["lifeguardInChargeID"].(int)
+		vehicleKind, legacyType := vehicleTypeFromString(vehicleTypeName)
+
+		resp, err := vehicleClient.CreateVehicle(p.Context, &CreateVehicleRequest{
+			LegacyType:          legacyType,
+			Location:            location,
+			FuelLevelInLiters:   int32(fuelLevelInLiters),
+			OnMission:           onMission,
+			LifeguardInChargeId: int64(lifeguardInChargeID),
+			Type:                vehicleKind,
+		})
+		if err != nil {
+			log.Printf("Nie udało się utworzyć pojazdu, error: %v\n", err)
+			return nil, graphqlServiceError("emergency-services", err)
+		}
+
+		log.Printf("Utworzono pojazd o id: %d\n", resp.Id)
+		return fetchVehicle(p.Context, resp.Id)
+	},
+}
+
+// Fields left out of the mutation keep their current values.
+var updateVehicleField = &graphql.Field{
+	Type: vehicleType,
+	Args: graphql.FieldConfigArgument{
+		"id": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"type": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"location": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"fuelLevelInLiters": &graphql.ArgumentConfig{
+			Type: graphql.Int,
+		},
+		"onMission": &graphql.ArgumentConfig{
+			Type: graphql.Boolean,
+		},
+		"lifeguardInChargeID": &graphql.ArgumentConfig{
+			Type: graphql.Int,
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		id := int64(p.Args["id"].(int))
+
+		current, err := fetchVehicle(p.Context, id)
+		if err != nil {
+			return nil, err
+		}
+
+		req := &UpdateVehicleRequest{
+			Id:                  id,
+			LegacyType:          current.LegacyType,
+			Location:            current.Location,
+			FuelLevelInLiters:   current.FuelLevelInLiters,
+			OnMission:           current.OnMission,
+			LifeguardInChargeId: current.LifeguardInChargeId,
+			Type:                current.Type,
+		}
+		if vehicleTypeName, ok := p.Args["type"].(string); ok {
+			req.Type, req.LegacyType = vehicleTypeFromString(vehicleTypeName)
+		}
+		if location, ok := p.Args["location"].(string); ok {
+			req.Location = location
+		}
+		if fuelLevelInLiters, ok := p.Args["fuelLevelInLiters"].(int); ok {
+			req.FuelLevelInLiters = int32(fuelLevelInLiters)
+		}
+		if onMission, ok := p.Args["onMission"].(bool); ok {
+			req.OnMission = onMission
+		}
+		if lifeguardInChargeID, ok := p.Args["lifeguardInChargeID"].(int); ok {
+			req.LifeguardInChargeId = int64(lifeguardInChargeID)
+		}
+
+		if _, err := vehicleClient.UpdateVehicle(p.Context, req); err != nil {
+			log.Printf("Nie udało się zaktualizować pojazdu o id: %d, error: %v\n", id, err)
+			return nil, graphqlServiceError("emergency-services", err)
+		}
+
+		log.Printf("Zaktualizowano pojazd o id: %d\n", id)
+		return fetchVehicle(p.Context, id)
+	},
+}
+
+var deleteVehicleField = &graphql.Field{
+	Type: deleteResponseType,
+	Args: graphql.FieldConfigArgument{
+		"id": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+	Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		id := p.Args["id"].(int)
+
+		if _, err := vehicleClient.DeleteVehicle(p.Context, &DeleteVehicleRequest{Id: int64(id)}); err != nil {
+			log.Printf("Nie udało się usunąć pojazdu o id: %d, error: %v\n", id, err)
+			return nil, graphqlServiceError("emergency-services", err)
+		}
+
+		log.Printf("Usunięto pojazd o id: %d\n", id)
+		return map[string]interface{}{
+			"success": true,
+		}, nil
+	},
+}
@@ -1,12 +1,14 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.14.0
 // source: vehicle.proto
 
 package main
 
 import (
+	_ "github.com/szbobrowski/master-thesis/validation"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,17 +22,83 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The type of a rescue vehicle.
+type VehicleType int32
+
+const (
+	VehicleType_VEHICLE_TYPE_UNSPECIFIED VehicleType = 0
+	VehicleType_VEHICLE_TYPE_BOAT        VehicleType = 1
+	VehicleType_VEHICLE_TYPE_JET_SKI     VehicleType = 2
+	VehicleType_VEHICLE_TYPE_QUAD        VehicleType = 3
+	VehicleType_VEHICLE_TYPE_CAR         VehicleType = 4
+	VehicleType_VEHICLE_TYPE_AMBULANCE   VehicleType = 5
+	VehicleType_VEHICLE_TYPE_DRONE       VehicleType = 6
+)
+
+// Enum value maps for VehicleType.
+var (
+	VehicleType_name = map[int32]string{
+		0: "VEHICLE_TYPE_UNSPECIFIED",
+		1: "VEHICLE_TYPE_BOAT",
+		2: "VEHICLE_TYPE_JET_SKI",
+		3: "VEHICLE_TYPE_QUAD",
+		4: "VEHICLE_TYPE_CAR",
+		5: "VEHICLE_TYPE_AMBULANCE",
+		6: "VEHICLE_TYPE_DRONE",
+	}
+	VehicleType_value = map[string]int32{
+		"VEHICLE_TYPE_UNSPECIFIED": 0,
+		"VEHICLE_TYPE_BOAT":        1,
+		"VEHICLE_TYPE_JET_SKI":     2,
+		"VEHICLE_TYPE_QUAD":        3,
+		"VEHICLE_TYPE_CAR":         4,
+		"VEHICLE_TYPE_AMBULANCE":   5,
+		"VEHICLE_TYPE_DRONE":       6,
+	}
+)
+
+func (x VehicleType) Enum() *VehicleType {
+	p := new(VehicleType)
+	*p = x
+	return p
+}
+
+func (x VehicleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VehicleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vehicle_proto_enumTypes[0].Descriptor()
+}
+
+func (VehicleType) Type() protoreflect.EnumType {
+	return &file_vehicle_proto_enumTypes[0]
+}
+
+func (x VehicleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VehicleType.Descriptor instead.
+func (VehicleType) EnumDescriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{0}
+}
+
 // The request message containing the vehicle details for creation.
 type CreateVehicleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type                string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Location            string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	FuelLevelInLiters   int32  `protobuf:"varint,3,opt,name=fuel_level_in_liters,json=fuelLevelInLiters,proto3" json:"fuel_level_in_liters,omitempty"`
-	OnMission           bool   `protobuf:"varint,4,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
-	LifeguardInChargeId int64  `protobuf:"varint,5,opt,name=lifeguard_in_charge_id,json=lifeguardInChargeId,proto3" json:"lifeguard_in_charge_id,omitempty"`
+	// Free-text type accepted from older clients, used only when type is unspecified.
+	//
+	// Deprecated: Marked as deprecated in vehicle.proto.
+	LegacyType          string      `protobuf:"bytes,1,opt,name=legacy_type,json=legacyType,proto3" json:"legacy_type,omitempty"`
+	Location            string      `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	FuelLevelInLiters   int32       `protobuf:"varint,3,opt,name=fuel_level_in_liters,json=fuelLevelInLiters,proto3" json:"fuel_level_in_liters,omitempty"`
+	OnMission           bool        `protobuf:"varint,4,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
+	LifeguardInChargeId int64       `protobuf:"varint,5,opt,name=lifeguard_in_charge_id,json=lifeguardInChargeId,proto3" json:"lifeguard_in_charge_id,omitempty"`
+	Type                VehicleType `protobuf:"varint,6,opt,name=type,proto3,enum=main.VehicleType" json:"type,omitempty"`
 }
 
 func (x *CreateVehicleRequest) Reset() {
@@ -65,9 +133,10 @@ func (*CreateVehicleRequest) Descriptor() ([]byte, []int) {
 	return file_vehicle_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *CreateVehicleRequest) GetType() string {
+// Deprecated: Marked as deprecated in vehicle.proto.
+func (x *CreateVehicleRequest) GetLegacyType() string {
 	if x != nil {
-		return x.Type
+		return x.LegacyType
 	}
 	return ""
 }
@@ -100,6 +169,13 @@ func (x *CreateVehicleRequest) GetLifeguardInChargeId() int64 {
 	return 0
 }
 
+func (x *CreateVehicleRequest) GetType() VehicleType {
+	if x != nil {
+		return x.Type
+	}
+	return VehicleType_VEHICLE_TYPE_UNSPECIFIED
+}
+
 // The response message containing the ID of the newly created vehicle.
 type CreateVehicleResponse struct {
 	state         protoimpl.MessageState
@@ -202,13 +278,17 @@ type GetVehicleResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type                string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Location            string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	FuelLevelInLiters   int32  `protobuf:"varint,4,opt,name=fuel_level_in_liters,json=fuelLevelInLiters,proto3" json:"fuel_level_in_liters,omitempty"`
-	OnMission           bool   `protobuf:"varint,5,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
-	LifeguardInChargeId int64  `protobuf:"varint,6,opt,name=lifeguard_in_charge_id,json=lifeguardInChargeId,proto3" json:"lifeguard_in_charge_id,omitempty"`
-	CreatedAt           string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // You can use string or google.protobuf.Timestamp
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type exactly as stored, kept for older clients and for values that cannot be normalized.
+	//
+	// Deprecated: Marked as deprecated in vehicle.proto.
+	LegacyType          string      `protobuf:"bytes,2,opt,name=legacy_type,json=legacyType,proto3" json:"legacy_type,omitempty"`
+	Location            string      `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	FuelLevelInLiters   int32       `protobuf:"varint,4,opt,name=fuel_level_in_liters,json=fuelLevelInLiters,proto3" json:"fuel_level_in_liters,omitempty"`
+	OnMission           bool        `protobuf:"varint,5,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
+	LifeguardInChargeId int64       `protobuf:"varint,6,opt,name=lifeguard_in_charge_id,json=lifeguardInChargeId,proto3" json:"lifeguard_in_charge_id,omitempty"`
+	CreatedAt           string      `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // You can use string or google.protobuf.Timestamp
+	Type                VehicleType `protobuf:"varint,8,opt,name=type,proto3,enum=main.VehicleType" json:"type,omitempty"`
 }
 
 func (x *GetVehicleResponse) Reset() {
@@ -250,9 +330,10 @@ func (x *GetVehicleResponse) GetId() int64 {
 	return 0
 }
 
-func (x *GetVehicleResponse) GetType() string {
+// Deprecated: Marked as deprecated in vehicle.proto.
+func (x *GetVehicleResponse) GetLegacyType() string {
 	if x != nil {
-		return x.Type
+		return x.LegacyType
 	}
 	return ""
 }
@@ -292,18 +373,29 @@ func (x *GetVehicleResponse) GetCreatedAt() string {
 	return ""
 }
 
+func (x *GetVehicleResponse) GetType() VehicleType {
+	if x != nil {
+		return x.Type
+	}
+	return VehicleType_VEHICLE_TYPE_UNSPECIFIED
+}
+
 // The request message containing the vehicle details for updating.
 type UpdateVehicleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type                string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Location            string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	FuelLevelInLiters   int32  `protobuf:"varint,4,opt,name=fuel_level_in_liters,json=fuelLevelInLiters,proto3" json:"fuel_level_in_liters,omitempty"`
-	OnMission           bool   `protobuf:"varint,5,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
-	LifeguardInChargeId int64  `protobuf:"varint,6,opt,name=lifeguard_in_charge_id,json=lifeguardInChargeId,proto3" json:"lifeguard_in_charge_id,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Free-text type accepted from older clients, used only when type is unspecified.
+	//
+	// Deprecated: Marked as deprecated in vehicle.proto.
+	LegacyType          string      `protobuf:"bytes,2,opt,name=legacy_type,json=legacyType,proto3" json:"legacy_type,omitempty"`
+	Location            string      `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	FuelLevelInLiters   int32       `protobuf:"varint,4,opt,name=fuel_level_in_liters,json=fuelLevelInLiters,proto3" json:"fuel_level_in_liters,omitempty"`
+	OnMission           bool        `protobuf:"varint,5,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
+	LifeguardInChargeId int64       `protobuf:"varint,6,opt,name=lifeguard_in_charge_id,json=lifeguardInChargeId,proto3" json:"lifeguard_in_charge_id,omitempty"`
+	Type                VehicleType `protobuf:"varint,7,opt,name=type,proto3,enum=main.VehicleType" json:"type,omitempty"`
 }
 
 func (x *UpdateVehicleRequest) Reset() {
@@ -345,9 +437,10 @@ func (x *UpdateVehicleRequest) GetId() int64 {
 	return 0
 }
 
-func (x *UpdateVehicleRequest) GetType() string {
+// Deprecated: Marked as deprecated in vehicle.proto.
+func (x *UpdateVehicleRequest) GetLegacyType() string {
 	if x != nil {
-		return x.Type
+		return x.LegacyType
 	}
 	return ""
 }
@@ -380,6 +473,101 @@ func (x *UpdateVehicleRequest) GetLifeguardInChargeId() int64 {
 	return 0
 }
 
+func (x *UpdateVehicleRequest) GetType() VehicleType {
+	if x != nil {
+		return x.Type
+	}
+	return VehicleType_VEHICLE_TYPE_UNSPECIFIED
+}
+
+// The request message containing the vehicle fields to change, the others keep their values.
+type PatchVehicleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  int64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                *VehicleType `protobuf:"varint,2,opt,name=type,proto3,enum=main.VehicleType,oneof" json:"type,omitempty"`
+	Location            *string      `protobuf:"bytes,3,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	FuelLevelInLiters   *int32       `protobuf:"varint,4,opt,name=fuel_level_in_liters,json=fuelLevelInLiters,proto3,oneof" json:"fuel_level_in_liters,omitempty"`
+	OnMission           *bool        `protobuf:"varint,5,opt,name=on_mission,json=onMission,proto3,oneof" json:"on_mission,omitempty"`
+	LifeguardInChargeId *int64       `protobuf:"varint,6,opt,name=lifeguard_in_charge_id,json=lifeguardInChargeId,proto3,oneof" json:"lifeguard_in_charge_id,omitempty"`
+}
+
+func (x *PatchVehicleRequest) Reset() {
+	*x = PatchVehicleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vehicle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchVehicleRequest) ProtoMessage() {}
+
+func (x *PatchVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchVehicleRequest.ProtoReflect.Descriptor instead.
+func (*PatchVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PatchVehicleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchVehicleRequest) GetType() VehicleType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return VehicleType_VEHICLE_TYPE_UNSPECIFIED
+}
+
+func (x *PatchVehicleRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *PatchVehicleRequest) GetFuelLevelInLiters() int32 {
+	if x != nil && x.FuelLevelInLiters != nil {
+		return *x.FuelLevelInLiters
+	}
+	return 0
+}
+
+func (x *PatchVehicleRequest) GetOnMission() bool {
+	if x != nil && x.OnMission != nil {
+		return *x.OnMission
+	}
+	return false
+}
+
+func (x *PatchVehicleRequest) GetLifeguardInChargeId() int64 {
+	if x != nil && x.LifeguardInChargeId != nil {
+		return *x.LifeguardInChargeId
+	}
+	return 0
+}
+
 // The response message confirming the vehicle update.
 type UpdateVehicleResponse struct {
 	state         protoimpl.MessageState
@@ -392,7 +580,7 @@ type UpdateVehicleResponse struct {
 func (x *UpdateVehicleResponse) Reset() {
 	*x = UpdateVehicleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vehicle_proto_msgTypes[5]
+		mi := &file_vehicle_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -405,7 +593,7 @@ func (x *UpdateVehicleResponse) String() string {
 func (*UpdateVehicleResponse) ProtoMessage() {}
 
 func (x *UpdateVehicleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vehicle_proto_msgTypes[5]
+	mi := &file_vehicle_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +606,7 @@ func (x *UpdateVehicleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVehicleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateVehicleResponse) Descriptor() ([]byte, []int) {
-	return file_vehicle_proto_rawDescGZIP(), []int{5}
+	return file_vehicle_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateVehicleResponse) GetSuccess() bool {
@@ -440,7 +628,7 @@ type DeleteVehicleRequest struct {
 func (x *DeleteVehicleRequest) Reset() {
 	*x = DeleteVehicleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vehicle_proto_msgTypes[6]
+		mi := &file_vehicle_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +641,7 @@ func (x *DeleteVehicleRequest) String() string {
 func (*DeleteVehicleRequest) ProtoMessage() {}
 
 func (x *DeleteVehicleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vehicle_proto_msgTypes[6]
+	mi := &file_vehicle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +654,7 @@ func (x *DeleteVehicleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVehicleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVehicleRequest) Descriptor() ([]byte, []int) {
-	return file_vehicle_proto_rawDescGZIP(), []int{6}
+	return file_vehicle_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteVehicleRequest) GetId() int64 {
@@ -488,7 +676,7 @@ type DeleteVehicleResponse struct {
 func (x *DeleteVehicleResponse) Reset() {
 	*x = DeleteVehicleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vehicle_proto_msgTypes[7]
+		mi := &file_vehicle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -501,7 +689,7 @@ func (x *DeleteVehicleResponse) String() string {
 func (*DeleteVehicleResponse) ProtoMessage() {}
 
 func (x *DeleteVehicleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vehicle_proto_msgTypes[7]
+	mi := &file_vehicle_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +702,7 @@ func (x *DeleteVehicleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVehicleResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVehicleResponse) Descriptor() ([]byte, []int) {
-	return file_vehicle_proto_rawDescGZIP(), []int{7}
+	return file_vehicle_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteVehicleResponse) GetSuccess() bool {
@@ -524,87 +712,392 @@ func (x *DeleteVehicleResponse) GetSuccess() bool {
 	return false
 }
 
+// The request message for listing vehicles, all of them when no lifeguard is given.
+type ListVehiclesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LifeguardInChargeId int64 `protobuf:"varint,1,opt,name=lifeguard_in_charge_id,json=lifeguardInChargeId,proto3" json:"lifeguard_in_charge_id,omitempty"`
+	// Vehicles in charge of any of these lifeguards, together with lifeguard_in_charge_id.
+	LifeguardInChargeIds []int64 `protobuf:"varint,2,rep,packed,name=lifeguard_in_charge_ids,json=lifeguardInChargeIds,proto3" json:"lifeguard_in_charge_ids,omitempty"`
+}
+
+func (x *ListVehiclesRequest) Reset() {
+	*x = ListVehiclesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vehicle_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesRequest) ProtoMessage() {}
+
+func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*ListVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListVehiclesRequest) GetLifeguardInChargeId() int64 {
+	if x != nil {
+		return x.LifeguardInChargeId
+	}
+	return 0
+}
+
+func (x *ListVehiclesRequest) GetLifeguardInChargeIds() []int64 {
+	if x != nil {
+		return x.LifeguardInChargeIds
+	}
+	return nil
+}
+
+// The response message containing the details of the listed vehicles.
+type ListVehiclesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vehicles []*GetVehicleResponse `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+}
+
+func (x *ListVehiclesResponse) Reset() {
+	*x = ListVehiclesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vehicle_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesResponse) ProtoMessage() {}
+
+func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*ListVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListVehiclesResponse) GetVehicles() []*GetVehicleResponse {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+// The request message containing the IDs of the vehicles to retrieve.
+type BatchGetVehiclesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchGetVehiclesRequest) Reset() {
+	*x = BatchGetVehiclesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vehicle_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetVehiclesRequest) ProtoMessage() {}
+
+func (x *BatchGetVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchGetVehiclesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// The response message containing the vehicles found, in no particular order. Unknown IDs are left out.
+type BatchGetVehiclesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vehicles []*GetVehicleResponse `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+}
+
+func (x *BatchGetVehiclesResponse) Reset() {
+	*x = BatchGetVehiclesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vehicle_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetVehiclesResponse) ProtoMessage() {}
+
+func (x *BatchGetVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchGetVehiclesResponse) GetVehicles() []*GetVehicleResponse {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
 var File_vehicle_proto protoreflect.FileDescriptor
 
 var file_vehicle_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x04, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x04, 0x6d, 0x61, 0x69, 0x6e, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x32, 0x18, 0x01, 0x52, 0x0a, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18,
+	0x03, 0x20, 0xc8, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a,
 	0x0a, 0x14, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x5f,
-	0x6c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x66, 0x75,
-	0x65, 0x6c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33,
-	0x0a, 0x16, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
-	0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67,
-	0x65, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68,
-	0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0xf8, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x66, 0x75, 0x65, 0x6c,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x66, 0x75, 0x65, 0x6c, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f,
-	0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x69, 0x66, 0x65,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x49, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdb, 0x01, 0x0a,
-	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x11, 0x66, 0x75, 0x65, 0x6c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e,
-	0x4c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6e, 0x4d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61,
-	0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x49, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x26, 0x0a,
-	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
-	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0xaf, 0x02, 0x0a, 0x0e, 0x56, 0x65, 0x68,
-	0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6d,
-	0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69,
-	0x63, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65,
-	0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d,
-	0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x48, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
-	0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
-	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xc2, 0xf3,
+	0x18, 0x05, 0x10, 0x00, 0x18, 0xe8, 0x07, 0x52, 0x11, 0x66, 0x75, 0x65, 0x6c, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x16, 0x6c, 0x69, 0x66,
+	0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10,
+	0x01, 0x52, 0x13, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x27, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xb0, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0b, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x66,
+	0x75, 0x65, 0x6c, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x66, 0x75, 0x65, 0x6c, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x6c,
+	0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x69, 0x66,
+	0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xbd, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18,
+	0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3,
+	0x18, 0x02, 0x20, 0x32, 0x18, 0x01, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x20, 0xc8, 0x01, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x14, 0x66, 0x75, 0x65, 0x6c, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xc2, 0xf3, 0x18, 0x05, 0x10, 0x00, 0x18, 0xe8, 0x07,
+	0x52, 0x11, 0x66, 0x75, 0x65, 0x6c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x4c, 0x69, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x16, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52, 0x13, 0x6c, 0x69, 0x66, 0x65,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x83, 0x03, 0x0a, 0x13, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02,
+	0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x20, 0xc8, 0x01, 0x48, 0x01, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x14,
+	0x66, 0x75, 0x65, 0x6c, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xc2, 0xf3, 0x18, 0x05,
+	0x10, 0x00, 0x18, 0xe8, 0x07, 0x48, 0x02, 0x52, 0x11, 0x66, 0x75, 0x65, 0x6c, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x03, 0x52, 0x09, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x40, 0x0a, 0x16, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x48, 0x04, 0x52, 0x13, 0x6c, 0x69, 0x66,
+	0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x66, 0x75,
+	0x65, 0x6c, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65,
+	0x72, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x2e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x16, 0x6c, 0x69,
+	0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02,
+	0x10, 0x00, 0x52, 0x13, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x17, 0x6c, 0x69, 0x66, 0x65, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x01,
+	0x38, 0x64, 0x52, 0x14, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x4c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x76, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a,
+	0xc2, 0xf3, 0x18, 0x06, 0x08, 0x01, 0x10, 0x01, 0x38, 0x64, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
+	0x50, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x73, 0x2a, 0xbd, 0x01, 0x0a, 0x0b, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x15, 0x0a, 0x11, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x42, 0x4f, 0x41, 0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x45, 0x54, 0x5f, 0x53, 0x4b, 0x49, 0x10, 0x02,
+	0x12, 0x15, 0x0a, 0x11, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x51, 0x55, 0x41, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x45, 0x48, 0x49, 0x43,
+	0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x52, 0x10, 0x04, 0x12, 0x1a, 0x0a,
+	0x16, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4d,
+	0x42, 0x55, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x45, 0x48,
+	0x49, 0x43, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x52, 0x4f, 0x4e, 0x45, 0x10,
+	0x06, 0x32, 0xce, 0x05, 0x0a, 0x0e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x32, 0x2f, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x12, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x66, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x76, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0c, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x32, 0x11, 0x2f, 0x76, 0x32,
+	0x2f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x63,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12,
+	0x1a, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x2a, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x5b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x32, 0x2f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x12, 0x70, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x32,
+	0x2f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -619,31 +1112,50 @@ func file_vehicle_proto_rawDescGZIP() []byte {
 	return file_vehicle_proto_rawDescData
 }
 
-var file_vehicle_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_vehicle_proto_goTypes = []interface{}{
-	(*CreateVehicleRequest)(nil),  // 0: main.CreateVehicleRequest
-	(*CreateVehicleResponse)(nil), // 1: main.CreateVehicleResponse
-	(*GetVehicleRequest)(nil),     // 2: main.GetVehicleRequest
-	(*GetVehicleResponse)(nil),    // 3: main.GetVehicleResponse
-	(*UpdateVehicleRequest)(nil),  // 4: main.UpdateVehicleRequest
-	(*UpdateVehicleResponse)(nil), // 5: main.UpdateVehicleResponse
-	(*DeleteVehicleRequest)(nil),  // 6: main.DeleteVehicleRequest
-	(*DeleteVehicleResponse)(nil), // 7: main.DeleteVehicleResponse
+var file_vehicle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_vehicle_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_vehicle_proto_goTypes = []any{
+	(VehicleType)(0),                 // 0: main.VehicleType
+	(*CreateVehicleRequest)(nil),     // 1: main.CreateVehicleRequest
+	(*CreateVehicleResponse)(nil),    // 2: main.CreateVehicleResponse
+	(*GetVehicleRequest)(nil),        // 3: main.GetVehicleRequest
+	(*GetVehicleResponse)(nil),       // 4: main.GetVehicleResponse
+	(*UpdateVehicleRequest)(nil),     // 5: main.UpdateVehicleRequest
+	(*PatchVehicleRequest)(nil),      // 6: main.PatchVehicleRequest
+	(*UpdateVehicleResponse)(nil),    // 7: main.UpdateVehicleResponse
+	(*DeleteVehicleRequest)(nil),     // 8: main.DeleteVehicleRequest
+	(*DeleteVehicleResponse)(nil),    // 9: main.DeleteVehicleResponse
+	(*ListVehiclesRequest)(nil),      // 10: main.ListVehiclesRequest
+	(*ListVehiclesResponse)(nil),     // 11: main.ListVehiclesResponse
+	(*BatchGetVehiclesRequest)(nil),  // 12: main.BatchGetVehiclesRequest
+	(*BatchGetVehiclesResponse)(nil), // 13: main.BatchGetVehiclesResponse
 }
 var file_vehicle_proto_depIdxs = []int32{
-	0, // 0: main.VehicleService.CreateVehicle:input_type -> main.CreateVehicleRequest
-	2, // 1: main.VehicleService.GetVehicle:input_type -> main.GetVehicleRequest
-	4, // 2: main.VehicleService.UpdateVehicle:input_type -> main.UpdateVehicleRequest
-	6, // 3: main.VehicleService.DeleteVehicle:input_type -> main.DeleteVehicleRequest
-	1, // 4: main.VehicleService.CreateVehicle:output_type -> main.CreateVehicleResponse
-	3, // 5: main.VehicleService.GetVehicle:output_type -> main.GetVehicleResponse
-	5, // 6: main.VehicleService.UpdateVehicle:output_type -> main.UpdateVehicleResponse
-	7, // 7: main.VehicleService.DeleteVehicle:output_type -> main.DeleteVehicleResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: main.CreateVehicleRequest.type:type_name -> main.VehicleType
+	0,  // 1: main.GetVehicleResponse.type:type_name -> main.VehicleType
+	0,  // 2: main.UpdateVehicleRequest.type:type_name -> main.VehicleType
+	0,  // 3: main.PatchVehicleRequest.type:type_name -> main.VehicleType
+	4,  // 4: main.ListVehiclesResponse.vehicles:type_name -> main.GetVehicleResponse
+	4,  // 5: main.BatchGetVehiclesResponse.vehicles:type_name -> main.GetVehicleResponse
+	1,  // 6: main.VehicleService.CreateVehicle:input_type -> main.CreateVehicleRequest
+	3,  // 7: main.VehicleService.GetVehicle:input_type -> main.GetVehicleRequest
+	5,  // 8: main.VehicleService.UpdateVehicle:input_type -> main.UpdateVehicleRequest
+	6,  // 9: main.VehicleService.PatchVehicle:input_type -> main.PatchVehicleRequest
+	8,  // 10: main.VehicleService.DeleteVehicle:input_type -> main.DeleteVehicleRequest
+	10, // 11: main.VehicleService.ListVehicles:input_type -> main.ListVehiclesRequest
+	12, // 12: main.VehicleService.BatchGetVehicles:input_type -> main.BatchGetVehiclesRequest
+	2,  // 13: main.VehicleService.CreateVehicle:output_type -> main.CreateVehicleResponse
+	4,  // 14: main.VehicleService.GetVehicle:output_type -> main.GetVehicleResponse
+	7,  // 15: main.VehicleService.UpdateVehicle:output_type -> main.UpdateVehicleResponse
+	4,  // 16: main.VehicleService.PatchVehicle:output_type -> main.GetVehicleResponse
+	9,  // 17: main.VehicleService.DeleteVehicle:output_type -> main.DeleteVehicleResponse
+	11, // 18: main.VehicleService.ListVehicles:output_type -> main.ListVehiclesResponse
+	13, // 19: main.VehicleService.BatchGetVehicles:output_type -> main.BatchGetVehiclesResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_vehicle_proto_init() }
@@ -652,7 +1164,7 @@ func file_vehicle_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_vehicle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_vehicle_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateVehicleRequest); i {
 			case 0:
 				return &v.state
@@ -664,7 +1176,7 @@ func file_vehicle_proto_init() {
 				return nil
 			}
 		}
-		file_vehicle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_vehicle_proto_msgTypes[1].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateVehicleResponse); i {
 			case 0:
 				return &v.state
@@ -676,7 +1188,7 @@ func file_vehicle_proto_init() {
 				return nil
 			}
 		}
-		file_vehicle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_vehicle_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*GetVehicleRequest); i {
 			case 0:
 				return &v.state
@@ -688,7 +1200,7 @@ func file_vehicle_proto_init() {
 				return nil
 			}
 		}
-		file_vehicle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_vehicle_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*GetVehicleResponse); i {
 			case 0:
 				return &v.state
@@ -700,7 +1212,7 @@ func file_vehicle_proto_init() {
 				return nil
 			}
 		}
-		file_vehicle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_vehicle_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateVehicleRequest); i {
 			case 0:
 				return &v.state
@@ -712,7 +1224,19 @@ func file_vehicle_proto_init() {
 				return nil
 			}
 		}
-		file_vehicle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_vehicle_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*PatchVehicleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vehicle_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateVehicleResponse); i {
 			case 0:
 				return &v.state
@@ -724,7 +1248,7 @@ func file_vehicle_proto_init() {
 				return nil
 			}
 		}
-		file_vehicle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_vehicle_proto_msgTypes[7].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteVehicleRequest); i {
 			case 0:
 				return &v.state
@@ -736,7 +1260,7 @@ func file_vehicle_proto_init() {
 				return nil
 			}
 		}
-		file_vehicle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_vehicle_proto_msgTypes[8].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteVehicleResponse); i {
 			case 0:
 				return &v.state
@@ -748,19 +1272,69 @@ func file_vehicle_proto_init() {
 				return nil
 			}
 		}
+		file_vehicle_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ListVehiclesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vehicle_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ListVehiclesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vehicle_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetVehiclesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vehicle_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetVehiclesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_vehicle_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vehicle_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_vehicle_proto_goTypes,
 		DependencyIndexes: file_vehicle_proto_depIdxs,
+		EnumInfos:         file_vehicle_proto_enumTypes,
 		MessageInfos:      file_vehicle_proto_msgTypes,
 	}.Build()
 	File_vehicle_proto = out.File
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/szbobrowski/master-thesis/auth"
+	"github.com/szbobrowski/master-thesis/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	apiKeySecretPrefix = "esk_"
+	apiKeyPrefixLength = len(apiKeySecretPrefix) + 8
+
+	// Last use is recorded at most once a minute per key, not on every request.
+	apiKeyUsageResolution = time.Minute
+)
+
+var apiKeyMethodPattern = regexp.MustCompile(`^/[A-Za-z0-9_.]+/([A-Za-z0-9_]+|\*)$`)
+
+func NewApiKeyServer(db *sql.DB) *server {
+	return &server{db: db}
+}
+
+func (s *server) mustEmbedUnimplementedApiKeyServiceServer() {
+	panic("nie zaimplementowano metody")
+}
+
+func (s *server) CreateApiKey(ctx context.Context, req *CreateApiKeyRequest) (*ApiKeySecretResponse, error) {
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, method := range req.Methods {
+		if !apiKeyMethodPattern.MatchString(method) {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("methods[%d]", i),
+				Description: "Oczekiwano pełnej nazwy metody, np. /main.IncidentService/CreateIncident lub /main.IncidentService/*",
+			})
+		}
+	}
+	expiresAt, err := apiKeyExpiry(req.ExpiresAt)
+	if err != nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: "expires_at", Description: err.Error()})
+	}
+	if len(violations) > 0 {
+		return nil, validation.InvalidArgumentError(violations)
+	}
+
+	secret, err := newApiKeySecret()
+	if err != nil {
+		return nil, err
+	}
+	claims, _ := auth.FromContext(ctx)
+	key := &ApiKeyDTO{
+		Name:      req.Name,
+		KeyPrefix: secret[:apiKeyPrefixLength],
+		KeyHash:   hashApiKey(secret),
+		Role:      req.Role,
+		Methods:   req.Methods,
+		CreatedBy: claims.Subject,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+		ExpiresAt: expiresAt,
+	}
+
+	id, err := CreateApiKey(s.db, key)
+	if err != nil {
+		log.Printf("Nie udało się utworzyć wiersza w tabeli api_keys: %v\n", err)
+		return nil, status.Error(codes.Internal, "Nie udało się utworzyć wiersza w tabeli api_keys")
+	}
+	key.ID = int(id)
+
+	log.Printf("Utworzono klucz API %s (%s), id wiersza: %d, rola: %s\n", key.KeyPrefix, key.Name, id, key.Role)
+
+	return &ApiKeySecretResponse{ApiKey: toApiKeyProto(key), Secret: secret}, nil
+}
+
+func (s *server) RotateApiKey(ctx context.Context, req *RotateApiKeyRequest) (*ApiKeySecretResponse, error) {
+	expiresAt, err := apiKeyExpiry(req.ExpiresAt)
+	if err != nil {
+		return nil, validation.InvalidArgumentError([]*errdetails.BadRequest_FieldViolation{{Field: "expires_at", Description: err.Error()}})
+	}
+
+	secret, err := newApiKeySecret()
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := RotateApiKey(s.db, int(req.Id), secret[:apiKeyPrefixLength], hashApiKey(secret), expiresAt)
+	if err != nil {
+		log.Printf("Nie udało się wymienić klucza API, id wiersza: %d, błąd: %v\n", req.Id, err)
+		return nil, status.Error(codes.Internal, "Nie udało się wymienić klucza API")
+	}
+
+	key, err := s.apiKey(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		return nil, status.Errorf(codes.FailedPrecondition, "Klucz API o ID %d został unieważniony", req.Id)
+	}
+
+	log.Printf("Wymieniono klucz API o ID %d, nowy prefiks: %s\n", req.Id, key.KeyPrefix)
+
+	return &ApiKeySecretResponse{ApiKey: toApiKeyProto(key), Secret: secret}, nil
+}
+
+func (s *server) RevokeApiKey(ctx context.Context, req *RevokeApiKeyRequest) (*ApiKeyResponse, error) {
+	if err := RevokeApiKey(s.db, int(req.Id), time.Now()); err != nil {
+		log.Printf("Nie udało się unieważnić klucza API, id wiersza: %d, błąd: %v\n", req.Id, err)
+		return nil, status.Error(codes.Internal, "Nie udało się unieważnić klucza API")
+	}
+
+	key, err := s.apiKey(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Unieważniono klucz API o ID %d\n", req.Id)
+
+	return &ApiKeyResponse{ApiKey: toApiKeyProto(key)}, nil
+}
+
+func (s *server) ListApiKeys(ctx context.Context, req *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	keys, err := ListApiKeys(s.db, req.IncludeRevoked)
+	if err != nil {
+		log.Printf("Nie udało się pobrać wierszy z tabeli api_keys, błąd: %v\n", err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wierszy z tabeli api_keys")
+	}
+
+	response := &ListApiKeysResponse{}
+	for i := range keys {
+		response.ApiKeys = append(response.ApiKeys, toApiKeyProto(&keys[i]))
+	}
+	return response, nil
+}
+
+func (s *server) AuthenticateApiKey(ctx context.Context, req *AuthenticateApiKeyRequest) (*ApiKeyResponse, error) {
+	key, err := GetApiKeyByHash(s.db, hashApiKey(req.Secret))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.Unauthenticated, "Niepoprawny klucz API")
+	}
+	if err != nil {
+		log.Printf("Nie udało się pobrać klucza API: %v\n", err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać klucza API")
+	}
+
+	now := time.Now()
+	if key.RevokedAt != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Klucz API %s został unieważniony", key.KeyPrefix)
+	}
+	if key.ExpiresAt != nil && !now.Before(*key.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "Klucz API %s wygasł", key.KeyPrefix)
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyUsageResolution {
+		if err := TouchApiKey(s.db, key.ID, now); err != nil {
+			log.Printf("Nie udało się zapisać użycia klucza API o ID %d: %v\n", key.ID, err)
+		} else {
+			usedAt := now.UTC()
+			key.LastUsedAt = &usedAt
+		}
+	}
+
+	return &ApiKeyResponse{ApiKey: toApiKeyProto(key)}, nil
+}
+
+func (s *server) apiKey(id int64) (*ApiKeyDTO, error) {
+	key, err := GetApiKeyByID(s.db, int(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Klucz API o ID %d nie znaleziony", id)
+	}
+	if err != nil {
+		log.Printf("Nie udało się pobrać wiersza z tabeli api_keys, id wiersza: %d, błąd: %v\n", id, err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wiersza z tabeli api_keys")
+	}
+	return key, nil
+}
+
+func toApiKeyProto(key *ApiKeyDTO) *ApiKey {
+	return &ApiKey{
+		Id:         int64(key.ID),
+		Name:       key.Name,
+		Prefix:     key.KeyPrefix,
+		Role:       key.Role,
+		Methods:    key.Methods,
+		CreatedBy:  key.CreatedBy,
+		CreatedAt:  key.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:  formatOptionalTime(key.ExpiresAt),
+		LastUsedAt: formatOptionalTime(key.LastUsedAt),
+		RevokedAt:  formatOptionalTime(key.RevokedAt),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// An empty value means no expiry time, or the current one for rotation.
+func apiKeyExpiry(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	if !expiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("Data wygaśnięcia musi być w przyszłości")
+	}
+	expiresAt = expiresAt.UTC()
+	return &expiresAt, nil
+}
+
+func newApiKeySecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", status.Error(codes.Internal, "Nie udało się wygenerować klucza API")
+	}
+	return apiKeySecretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashApiKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
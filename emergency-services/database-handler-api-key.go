@@ -0,0 +1,175 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	apiKeyColumns   = `ID, Name, KeyPrefix, KeyHash, Role, Methods, CreatedBy, CreatedAt, ExpiresAt, LastUsedAt, RevokedAt`
+	timestampLayout = "2006-01-02 15:04:05"
+)
+
+// Timestamps of API keys are written in UTC by the service, so that expiry does not depend on the time zone of the database.
+func dbTimestamp(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(timestampLayout)
+}
+
+func CreateApiKey(db *sql.DB, key *ApiKeyDTO) (int64, error) {
+	methods, err := json.Marshal(key.Methods)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+		INSERT INTO api_keys (Name, KeyPrefix, KeyHash, Role, Methods, CreatedBy, CreatedAt, ExpiresAt)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	result, err := db.Exec(query, key.Name, key.KeyPrefix, key.KeyHash, key.Role, string(methods), key.CreatedBy, dbTimestamp(&key.CreatedAt), dbTimestamp(key.ExpiresAt))
+	if err != nil {
+		return 0, fmt.Errorf("Nie udało się utworzyć klucza API: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("Błąd podczas pobierania ID ostatniego wiersza: %w", err)
+	}
+
+	return id, nil
+}
+
+// The returned error wraps sql.ErrNoRows when there is no such key.
+func GetApiKeyByID(db *sql.DB, id int) (*ApiKeyDTO, error) {
+	return queryApiKey(db, `SELECT `+apiKeyColumns+` FROM api_keys WHERE ID = ?`, id)
+}
+
+func GetApiKeyByHash(db *sql.DB, keyHash string) (*ApiKeyDTO, error) {
+	return queryApiKey(db, `SELECT `+apiKeyColumns+` FROM api_keys WHERE KeyHash = ?`, keyHash)
+}
+
+// A nil expiresAt keeps the current expiry time. Revoked keys cannot be rotated.
+func RotateApiKey(db *sql.DB, id int, keyPrefix, keyHash string, expiresAt *time.Time) (bool, error) {
+	query := `
+		UPDATE api_keys
+		SET KeyPrefix = ?, KeyHash = ?, ExpiresAt = COALESCE(?, ExpiresAt), LastUsedAt = NULL
+		WHERE ID = ? AND RevokedAt IS NULL
+	`
+	result, err := db.Exec(query, keyPrefix, keyHash, dbTimestamp(expiresAt), id)
+	if err != nil {
+		return false, fmt.Errorf("Błąd podczas wymiany klucza API: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	return rows > 0, err
+}
+
+func RevokeApiKey(db *sql.DB, id int, revokedAt time.Time) error {
+	query := `UPDATE api_keys SET RevokedAt = ? WHERE ID = ? AND RevokedAt IS NULL`
+	_, err := db.Exec(query, dbTimestamp(&revokedAt), id)
+	if err != nil {
+		return fmt.Errorf("Błąd podczas unieważniania klucza API: %w", err)
+	}
+	return nil
+}
+
+func TouchApiKey(db *sql.DB, id int, usedAt time.Time) error {
+	_, err := db.Exec(`UPDATE api_keys SET LastUsedAt = ? WHERE ID = ?`, dbTimestamp(&usedAt), id)
+	if err != nil {
+		return fmt.Errorf("Błąd podczas zapisywania użycia klucza API: %w", err)
+	}
+	return nil
+}
+
+func ListApiKeys(db *sql.DB, includeRevoked bool) ([]ApiKeyDTO, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys`
+	if !includeRevoked {
+		query += ` WHERE RevokedAt IS NULL`
+	}
+
+	rows, err := db.Query(query + ` ORDER BY ID`)
+	if err != nil {
+		return nil, fmt.Errorf("Błąd podczas pobierania listy kluczy API: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []ApiKeyDTO
+	for rows.Next() {
+		key, err := scanApiKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+
+	return keys, rows.Err()
+}
+
+func queryApiKey(db *sql.DB, query string, arg interface{}) (*ApiKeyDTO, error) {
+	key, err := scanApiKey(db.QueryRow(query, arg))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("Klucz API nie znaleziony: %w", err)
+	}
+	return key, err
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanApiKey(row rowScanner) (*ApiKeyDTO, error) {
+	var key ApiKeyDTO
+	var methods, createdBy sql.NullString
+	var createdAt, expiresAt, lastUsedAt, revokedAt []byte
+
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.KeyPrefix,
+		&key.KeyHash,
+		&key.Role,
+		&methods,
+		&createdBy,
+		&createdAt,
+		&expiresAt,
+		&lastUsedAt,
+		&revokedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Błąd podczas odczytu klucza API: %w", err)
+	}
+
+	key.CreatedBy = createdBy.String
+	if methods.String != "" {
+		if err := json.Unmarshal([]byte(methods.String), &key.Methods); err != nil {
+			return nil, fmt.Errorf("Błąd podczas parsowania pola Methods: %w", err)
+		}
+	}
+
+	key.CreatedAt, err = time.Parse(timestampLayout, string(createdAt))
+	if err != nil {
+		return nil, fmt.Errorf("Błąd podczas parsowania pola CreatedAt: %w", err)
+	}
+	for _, field := range []struct {
+		value  []byte
+		target **time.Time
+	}{{expiresAt, &key.ExpiresAt}, {lastUsedAt, &key.LastUsedAt}, {revokedAt, &key.RevokedAt}} {
+		if field.value == nil {
+			continue
+		}
+		parsed, err := time.Parse(timestampLayout, string(field.value))
+		if err != nil {
+			return nil, fmt.Errorf("Błąd podczas parsowania znacznika czasu klucza API: %w", err)
+		}
+		*field.target = &parsed
+	}
+
+	return &key, nil
+}
@@ -43,7 +43,7 @@ func GetLifeguardByID(db *sql.DB, id int) (*LifeguardDTO, error) {
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("Ratownik o ID %d nie znaleziony", id)
+			return nil, fmt.Errorf("Ratownik o ID %d nie znaleziony: %w", id, err)
 		}
 		return nil, fmt.Errorf("Błąd podczas pobierania ratownika: %w", err)
 	}
@@ -63,10 +63,13 @@ func UpdateLifeguard(db *sql.DB, id int, name, login, passwordHash string, years
 		WHERE ID = ?
 	`
 
-	_, err := db.Exec(query, name, login, passwordHash, yearsOfExperience, specialization, onMission, id)
+	result, err := db.Exec(query, name, login, passwordHash, yearsOfExperience, specialization, onMission, id)
 	if err != nil {
 		return fmt.Errorf("Błąd podczas aktualizowania ratownika: %w", err)
 	}
+	if err := requireRow(result, id); err != nil {
+		return err
+	}
 
 	fmt.Printf("Zaktualizowano ratownika o ID %d!\n", id)
 	return nil
@@ -74,11 +77,60 @@ func UpdateLifeguard(db *sql.DB, id int, name, login, passwordHash string, years
 
 func DeleteLifeguard(db *sql.DB, id int) error {
 	query := `DELETE FROM lifeguards WHERE ID = ?`
-	_, err := db.Exec(query, id)
+	result, err := db.Exec(query, id)
 	if err != nil {
 		return fmt.Errorf("Błąd podczas usuwania ratownika: %w", err)
 	}
+	if err := requireRow(result, id); err != nil {
+		return err
+	}
 
 	fmt.Printf("Ratownik o ID %d został usunięty!\n", id)
 	return nil
 }
+
+func ListLifeguards(db *sql.DB) ([]LifeguardDTO, error) {
+	return queryLifeguards(db, `SELECT ID, Name, Login, PasswordHash, YearsOfExperience, Specialization, OnMission, CreatedAt FROM lifeguards ORDER BY ID`)
+}
+
+func GetLifeguardsByIDs(db *sql.DB, ids []int64) ([]LifeguardDTO, error) {
+	query := `SELECT ID, Name, Login, PasswordHash, YearsOfExperience, Specialization, OnMission, CreatedAt FROM lifeguards WHERE ID IN (` + placeholders(len(ids)) + `)`
+	return queryLifeguards(db, query, int64Args(ids)...)
+}
+
+func queryLifeguards(db *sql.DB, query string, args ...interface{}) ([]LifeguardDTO, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Błąd podczas pobierania listy ratowników: %w", err)
+	}
+	defer rows.Close()
+
+	var lifeguards []LifeguardDTO
+	for rows.Next() {
+		var lifeguard LifeguardDTO
+		var createdAt []byte
+
+		err := rows.Scan(
+			&lifeguard.ID,
+			&lifeguard.Name,
+			&lifeguard.Login,
+			&lifeguard.PasswordHash,
+			&lifeguard.YearsOfExperience,
+			&lifeguard.Specialization,
+			&lifeguard.OnMission,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Błąd podczas odczytu ratownika: %w", err)
+		}
+
+		lifeguard.CreatedAt, err = time.Parse("2006-01-02 15:04:05", string(createdAt))
+		if err != nil {
+			return nil, fmt.Errorf("Błąd podczas parsowania pola CreatedAt: %w", err)
+		}
+
+		lifeguards = append(lifeguards, lifeguard)
+	}
+
+	return lifeguards, rows.Err()
+}
@@ -41,7 +41,7 @@ func GetVehicleByID(db *sql.DB, id int) (*VehicleDTO, error) {
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("Pojazd o ID %d nie znaleziony", id)
+			return nil, fmt.Errorf("Pojazd o ID %d nie znaleziony: %w", id, err)
 		}
 		return nil, fmt.Errorf("Błąd podczas pobierania pojazdu: %w", err)
 	}
@@ -60,10 +60,13 @@ func UpdateVehicle(db *sql.DB, id int, vehicleType, location string, fuelLevelIn
 		SET Type = ?, Location = ?, FuelLevelInLiters = ?, OnMission = ?, LifeguardInChargeID = ?
 		WHERE ID = ?
 	`
-	_, err := db.Exec(query, vehicleType, location, fuelLevelInLiters, onMission, lifeguardInChargeID, id)
+	result, err := db.Exec(query, vehicleType, location, fuelLevelInLiters, onMission, lifeguardInChargeID, id)
 	if err != nil {
 		return fmt.Errorf("Błąd podczas aktualizowania pojazdu: %w", err)
 	}
+	if err := requireRow(result, id); err != nil {
+		return err
+	}
 
 	fmt.Printf("Zaktualizowano pojazd o ID %d!\n", id)
 	return nil
@@ -71,11 +74,67 @@ func UpdateVehicle(db *sql.DB, id int, vehicleType, location string, fuelLevelIn
 
 func DeleteVehicle(db *sql.DB, id int) error {
 	query := `DELETE FROM vehicles WHERE ID = ?`
-	_, err := db.Exec(query, id)
+	result, err := db.Exec(query, id)
 	if err != nil {
 		return fmt.Errorf("Błąd podczas usuwania pojazdu: %w", err)
 	}
+	if err := requireRow(result, id); err != nil {
+		return err
+	}
 
 	fmt.Printf("Pojazd o ID %d został usunięty!\n", id)
 	return nil
 }
+
+const vehicleColumns = `ID, Type, Location, FuelLevelInLiters, OnMission, LifeguardInChargeID, CreatedAt`
+
+// Lists all vehicles when no lifeguard is given.
+func ListVehicles(db *sql.DB, lifeguardInChargeIDs []int64) ([]VehicleDTO, error) {
+	if len(lifeguardInChargeIDs) == 0 {
+		return queryVehicles(db, `SELECT `+vehicleColumns+` FROM vehicles ORDER BY ID`)
+	}
+
+	query := `SELECT ` + vehicleColumns + ` FROM vehicles WHERE LifeguardInChargeID IN (` + placeholders(len(lifeguardInChargeIDs)) + `) ORDER BY ID`
+	return queryVehicles(db, query, int64Args(lifeguardInChargeIDs)...)
+}
+
+func GetVehiclesByIDs(db *sql.DB, ids []int64) ([]VehicleDTO, error) {
+	query := `SELECT ` + vehicleColumns + ` FROM vehicles WHERE ID IN (` + placeholders(len(ids)) + `)`
+	return queryVehicles(db, query, int64Args(ids)...)
+}
+
+func queryVehicles(db *sql.DB, query string, args ...interface{}) ([]VehicleDTO, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Błąd podczas pobierania listy pojazdów: %w", err)
+	}
+	defer rows.Close()
+
+	var vehicles []VehicleDTO
+	for rows.Next() {
+		var vehicle VehicleDTO
+		var createdAt []byte
+
+		err := rows.Scan(
+			&vehicle.ID,
+			&vehicle.Type,
+			&vehicle.Location,
+			&vehicle.FuelLevelInLiters,
+			&vehicle.OnMission,
+			&vehicle.LifeguardInChargeID,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Błąd podczas odczytu pojazdu: %w", err)
+		}
+
+		vehicle.CreatedAt, err = time.Parse("2006-01-02 15:04:05", string(createdAt))
+		if err != nil {
+			return nil, fmt.Errorf("Błąd podczas parsowania pola CreatedAt: %w", err)
+		}
+
+		vehicles = append(vehicles, vehicle)
+	}
+
+	return vehicles, rows.Err()
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 )
 
 func ConnectToDB(dsn string) (*sql.DB, error) {
@@ -19,3 +20,29 @@ func ConnectToDB(dsn string) (*sql.DB, error) {
 	fmt.Println("Udało się połączyć z bazą danych!")
 	return db, nil
 }
+
+// requireRow reports a statement that matched no row with an error wrapping sql.ErrNoRows. The DSN has to
+// set clientFoundRows, otherwise MySQL counts only changed rows and an update to the same values looks the same.
+func requireRow(result sql.Result, id int) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Błąd podczas odczytu liczby zmienionych wierszy: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("Wiersz o ID %d nie istnieje: %w", id, sql.ErrNoRows)
+	}
+	return nil
+}
+
+// placeholders returns a list of n query parameters for an IN clause.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func int64Args(values []int64) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		args[i] = value
+	}
+	return args
+}
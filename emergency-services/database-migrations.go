@@ -0,0 +1,67 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+)
+
+func NormalizeLifeguardSpecializations(db *sql.DB) error {
+	return normalizeColumn(db, "lifeguards", "Specialization", func(value string) (string, bool) {
+		specialization, ok := parseSpecialization(value)
+		return specializationName(specialization), ok
+	})
+}
+
+func NormalizeVehicleTypes(db *sql.DB) error {
+	return normalizeColumn(db, "vehicles", "Type", func(value string) (string, bool) {
+		vehicleType, ok := parseVehicleType(value)
+		return vehicleTypeName(vehicleType), ok
+	})
+}
+
+// normalizeColumn rewrites the free-text values of a MySQL column to the names of the enum. It reads all rows
+// first and updates them one by one afterwards, so the result set is not held open during the updates;
+// unrecognized values are only logged and stay as they are for manual review.
+func normalizeColumn(db *sql.DB, table, column string, normalize func(string) (string, bool)) error {
+	rows, err := db.Query(fmt.Sprintf("SELECT ID, %s FROM %s", column, table))
+	if err != nil {
+		return fmt.Errorf("Błąd podczas odczytu kolumny %s z tabeli %s: %w", column, table, err)
+	}
+
+	updates := map[int]string{}
+	for rows.Next() {
+		var id int
+		var value sql.NullString
+		if err := rows.Scan(&id, &value); err != nil {
+			rows.Close()
+			return fmt.Errorf("Błąd podczas odczytu kolumny %s z tabeli %s: %w", column, table, err)
+		}
+		if !value.Valid || value.String == "" {
+			continue
+		}
+
+		normalized, ok := normalize(value.String)
+		if !ok {
+			log.Printf("Nie udało się znormalizować wartości %q w kolumnie %s tabeli %s, id wiersza: %d\n", value.String, column, table, id)
+			continue
+		}
+		if normalized != value.String {
+			updates[id] = normalized
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Błąd podczas odczytu kolumny %s z tabeli %s: %w", column, table, err)
+	}
+
+	for id, normalized := range updates {
+		_, err := db.Exec(fmt.Sprintf("UPDATE %s SET %s = ? WHERE ID = ?", table, column), normalized, id)
+		if err != nil {
+			return fmt.Errorf("Błąd podczas normalizacji kolumny %s w tabeli %s: %w", column, table, err)
+		}
+	}
+
+	fmt.Printf("Znormalizowano %d wierszy w kolumnie %s tabeli %s\n", len(updates), column, table)
+	return nil
+}
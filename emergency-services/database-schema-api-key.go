@@ -0,0 +1,65 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Only the SHA-256 hash of a key is stored. Keys are random and long, so a slow password hash is not needed.
+type ApiKeyDTO struct {
+	ID         int
+	Name       string
+	KeyPrefix  string
+	KeyHash    string
+	Role       string
+	Methods    []string
+	CreatedBy  string
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func CreateApiKeysTable(db *sql.DB) error {
+	checkTableQuery := `
+		SELECT COUNT(*)
+		FROM information_schema.tables
+		WHERE table_schema = DATABASE() AND table_name = 'api_keys';
+	`
+
+	var count int
+	err := db.QueryRow(checkTableQuery).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("error checking if table exists: %w", err)
+	}
+
+	if count == 0 {
+		createTableQuery := `
+			CREATE TABLE api_keys (
+				ID INT AUTO_INCREMENT PRIMARY KEY,
+				Name VARCHAR(100) NOT NULL,
+				KeyPrefix VARCHAR(16) NOT NULL,
+				KeyHash CHAR(64) NOT NULL,
+				Role VARCHAR(32) NOT NULL,
+				Methods TEXT,
+				CreatedBy VARCHAR(200),
+				CreatedAt TIMESTAMP NOT NULL,
+				ExpiresAt TIMESTAMP NULL,
+				LastUsedAt TIMESTAMP NULL,
+				RevokedAt TIMESTAMP NULL,
+				UNIQUE KEY api_keys_key_hash (KeyHash)
+			);
+		`
+		_, err = db.Exec(createTableQuery)
+		if err != nil {
+			return fmt.Errorf("Błąd podczas tworzenia tabeli api_keys: %w", err)
+		}
+
+		fmt.Println("Pomyślnie utworzono tabelę api_keys!")
+	} else {
+		fmt.Println("Tabela api_keys już istnieje.")
+	}
+
+	return nil
+}
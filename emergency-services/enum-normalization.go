@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/szbobrowski/master-thesis/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+const (
+	specializationPrefix = "SPECIALIZATION_"
+	vehicleTypePrefix    = "VEHICLE_TYPE_"
+)
+
+var specializationSynonyms = map[string]int32{
+	"plaża":              int32(Specialization_SPECIALIZATION_BEACH),
+	"plaza":              int32(Specialization_SPECIALIZATION_BEACH),
+	"ratownik plażowy":   int32(Specialization_SPECIALIZATION_BEACH),
+	"basen":              int32(Specialization_SPECIALIZATION_POOL),
+	"pływalnia":          int32(Specialization_SPECIALIZATION_POOL),
+	"wody otwarte":       int32(Specialization_SPECIALIZATION_OPEN_WATER),
+	"akwen otwarty":      int32(Specialization_SPECIALIZATION_OPEN_WATER),
+	"nurek":              int32(Specialization_SPECIALIZATION_DIVER),
+	"płetwonurek":        int32(Specialization_SPECIALIZATION_DIVER),
+	"scuba":              int32(Specialization_SPECIALIZATION_DIVER),
+	"ratownik medyczny":  int32(Specialization_SPECIALIZATION_PARAMEDIC),
+	"medyk":              int32(Specialization_SPECIALIZATION_PARAMEDIC),
+	"sternik":            int32(Specialization_SPECIALIZATION_BOAT_OPERATOR),
+	"motorowodny":        int32(Specialization_SPECIALIZATION_BOAT_OPERATOR),
+	"skipper":            int32(Specialization_SPECIALIZATION_BOAT_OPERATOR),
+	"operator łodzi":     int32(Specialization_SPECIALIZATION_BOAT_OPERATOR),
+	"ratownik motorowy":  int32(Specialization_SPECIALIZATION_BOAT_OPERATOR),
+	"ratownik basenowy":  int32(Specialization_SPECIALIZATION_POOL),
+	"ratownik nurkujący": int32(Specialization_SPECIALIZATION_DIVER),
+}
+
+var vehicleTypeSynonyms = map[string]int32{
+	"łódź":          int32(VehicleType_VEHICLE_TYPE_BOAT),
+	"lodz":          int32(VehicleType_VEHICLE_TYPE_BOAT),
+	"łódka":         int32(VehicleType_VEHICLE_TYPE_BOAT),
+	"motorówka":     int32(VehicleType_VEHICLE_TYPE_BOAT),
+	"ponton":        int32(VehicleType_VEHICLE_TYPE_BOAT),
+	"motorboat":     int32(VehicleType_VEHICLE_TYPE_BOAT),
+	"jetski":        int32(VehicleType_VEHICLE_TYPE_JET_SKI),
+	"skuter":        int32(VehicleType_VEHICLE_TYPE_JET_SKI),
+	"skuter wodny":  int32(VehicleType_VEHICLE_TYPE_JET_SKI),
+	"atv":           int32(VehicleType_VEHICLE_TYPE_QUAD),
+	"samochód":      int32(VehicleType_VEHICLE_TYPE_CAR),
+	"samochod":      int32(VehicleType_VEHICLE_TYPE_CAR),
+	"auto":          int32(VehicleType_VEHICLE_TYPE_CAR),
+	"terenówka":     int32(VehicleType_VEHICLE_TYPE_CAR),
+	"ambulans":      int32(VehicleType_VEHICLE_TYPE_AMBULANCE),
+	"karetka":       int32(VehicleType_VEHICLE_TYPE_AMBULANCE),
+	"dron":          int32(VehicleType_VEHICLE_TYPE_DRONE),
+	"bezzałogowiec": int32(VehicleType_VEHICLE_TYPE_DRONE),
+}
+
+func normalizeEnumKey(value string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-'
+	}), " ")
+}
+
+func parseEnum(value, prefix string, values map[string]int32, synonyms map[string]int32) (int32, bool) {
+	key := normalizeEnumKey(value)
+	if key == "" {
+		return 0, false
+	}
+
+	for name, number := range values {
+		if number == 0 {
+			continue
+		}
+		if key == normalizeEnumKey(name) || key == normalizeEnumKey(strings.TrimPrefix(name, prefix)) {
+			return number, true
+		}
+	}
+
+	number, ok := synonyms[key]
+	return number, ok
+}
+
+func parseSpecialization(value string) (Specialization, bool) {
+	number, ok := parseEnum(value, specializationPrefix, Specialization_value, specializationSynonyms)
+	return Specialization(number), ok
+}
+
+func parseVehicleType(value string) (VehicleType, bool) {
+	number, ok := parseEnum(value, vehicleTypePrefix, VehicleType_value, vehicleTypeSynonyms)
+	return VehicleType(number), ok
+}
+
+func specializationName(specialization Specialization) string {
+	return strings.TrimPrefix(specialization.String(), specializationPrefix)
+}
+
+func vehicleTypeName(vehicleType VehicleType) string {
+	return strings.TrimPrefix(vehicleType.String(), vehicleTypePrefix)
+}
+
+func resolveSpecialization(specialization Specialization, legacy string) (string, error) {
+	if specialization != Specialization_SPECIALIZATION_UNSPECIFIED {
+		if _, ok := Specialization_name[int32(specialization)]; !ok {
+			return "", enumViolation("specialization", fmt.Sprintf("Nieznana specjalizacja: %d", int32(specialization)))
+		}
+		return specializationName(specialization), nil
+	}
+
+	if strings.TrimSpace(legacy) == "" {
+		return "", nil
+	}
+
+	parsed, ok := parseSpecialization(legacy)
+	if !ok {
+		return "", enumViolation("legacy_specialization", fmt.Sprintf("Nieznana specjalizacja: %q", legacy))
+	}
+	return specializationName(parsed), nil
+}
+
+func resolveVehicleType(vehicleType VehicleType, legacy string) (string, error) {
+	if vehicleType != VehicleType_VEHICLE_TYPE_UNSPECIFIED {
+		if _, ok := VehicleType_name[int32(vehicleType)]; !ok {
+			return "", enumViolation("type", fmt.Sprintf("Nieznany typ pojazdu: %d", int32(vehicleType)))
+		}
+		return vehicleTypeName(vehicleType), nil
+	}
+
+	if strings.TrimSpace(legacy) == "" {
+		return "", enumViolation("type", "Pole jest wymagane")
+	}
+
+	parsed, ok := parseVehicleType(legacy)
+	if !ok {
+		return "", enumViolation("legacy_type", fmt.Sprintf("Nieznany typ pojazdu: %q", legacy))
+	}
+	return vehicleTypeName(parsed), nil
+}
+
+func enumViolation(field, description string) error {
+	return validation.InvalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+		{Field: field, Description: description},
+	})
+}
@@ -2,13 +2,23 @@ module github.com/szbobrowski/master-thesis/emergency-services
 
 go 1.22.5
 
+require (
+	github.com/go-sql-driver/mysql v1.8.1
+	github.com/szbobrowski/master-thesis/auth v0.0.0
+	github.com/szbobrowski/master-thesis/validation v0.0.0
+	google.golang.org/genproto/googleapis/api v0.0.0-20240528184218-531527333157
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240604185151-ef581f913117
+	google.golang.org/grpc v1.65.0
+	google.golang.org/protobuf v1.34.2
+)
+
 require (
 	filippo.io/edwards25519 v1.1.0 // indirect
-	github.com/go-sql-driver/mysql v1.8.1 // indirect
-	golang.org/x/net v0.25.0 // indirect
-	golang.org/x/sys v0.20.0 // indirect
-	golang.org/x/text v0.15.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240528184218-531527333157 // indirect
-	google.golang.org/grpc v1.65.0 // indirect
-	google.golang.org/protobuf v1.34.2 // indirect
+	golang.org/x/net v0.26.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
 )
+
+replace github.com/szbobrowski/master-thesis/auth => ../auth
+
+replace github.com/szbobrowski/master-thesis/validation => ../validation
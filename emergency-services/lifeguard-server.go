@@ -3,9 +3,13 @@ package main
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"errors"
 	"log"
+	"strings"
 	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type server struct {
@@ -21,10 +25,15 @@ func (s *server) mustEmbedUnimplementedLifeguardServiceServer() {
 }
 
 func (s *server) CreateLifeguard(ctx context.Context, req *CreateLifeguardRequest) (*CreateLifeguardResponse, error) {
-	id, err := CreateLifeguard(s.db, req.Name, req.Login, req.PasswordHash, int(req.YearsOfExperience), req.Specialization, req.OnMission)
+	specialization, err := resolveSpecialization(req.Specialization, req.LegacySpecialization)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := CreateLifeguard(s.db, req.Name, req.Login, req.PasswordHash, int(req.YearsOfExperience), specialization, req.OnMission)
 	if err != nil {
 		log.Printf("Nie udało się utworzyć wiersza w tabeli lifeguards: %v\n", err)
-		return nil, fmt.Errorf("Nie udało się utworzyć wiersza w tabeli lifeguards: %w", err)
+		return nil, status.Error(codes.Internal, "Nie udało się utworzyć wiersza w tabeli lifeguards")
 	}
 
 	log.Printf("Utworzono wiersz w tabeli lifeguards, id wiersza: %d\n", id)
@@ -34,30 +43,51 @@ func (s *server) CreateLifeguard(ctx context.Context, req *CreateLifeguardReques
 
 func (s *server) GetLifeguard(ctx context.Context, req *GetLifeguardRequest) (*GetLifeguardResponse, error) {
 	lifeguard, err := GetLifeguardByID(s.db, int(req.Id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Ratownik o ID %d nie znaleziony", req.Id)
+	}
 	if err != nil {
 		log.Printf("Nie udało się pobrać wiersza z tabeli lifeguards, id wiersza: %d, błąd: %v\n", req.Id, err)
-		return nil, fmt.Errorf("Nie udało się pobrać wiersza z tabeli lifeguards: %w", err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wiersza z tabeli lifeguards")
 	}
 
 	log.Printf("Pobrano wiersz z tabeli lifeguards: %+v\n", lifeguard)
 
+	return toLifeguardResponse(lifeguard), nil
+}
+
+func toLifeguardResponse(lifeguard *LifeguardDTO) *GetLifeguardResponse {
+	specialization, _ := parseSpecialization(lifeguard.Specialization)
+
 	return &GetLifeguardResponse{
-		Id:                int64(lifeguard.ID),
-		Name:              lifeguard.Name,
-		Login:             lifeguard.Login,
-		PasswordHash:      lifeguard.PasswordHash,
-		YearsOfExperience: int32(lifeguard.YearsOfExperience),
-		Specialization:    lifeguard.Specialization,
-		OnMission:         lifeguard.OnMission,
-		CreatedAt:         lifeguard.CreatedAt.Format(time.RFC3339),
-	}, nil
+		Id:                   int64(lifeguard.ID),
+		Name:                 lifeguard.Name,
+		Login:                lifeguard.Login,
+		PasswordHash:         lifeguard.PasswordHash,
+		YearsOfExperience:    int32(lifeguard.YearsOfExperience),
+		LegacySpecialization: lifeguard.Specialization,
+		OnMission:            lifeguard.OnMission,
+		CreatedAt:            lifeguard.CreatedAt.Format(time.RFC3339),
+		Specialization:       specialization,
+	}
 }
 
 func (s *server) UpdateLifeguard(ctx context.Context, req *UpdateLifeguardRequest) (*UpdateLifeguardResponse, error) {
-	err := UpdateLifeguard(s.db, int(req.Id), req.Name, req.Login, req.PasswordHash, int(req.YearsOfExperience), req.Specialization, req.OnMission)
+	specialization, err := resolveSpecialization(req.Specialization, req.LegacySpecialization)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkOwnMissionUpdate(ctx, req, specialization); err != nil {
+		return nil, err
+	}
+
+	err = UpdateLifeguard(s.db, int(req.Id), req.Name, req.Login, req.PasswordHash, int(req.YearsOfExperience), specialization, req.OnMission)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Ratownik o ID %d nie znaleziony", req.Id)
+	}
 	if err != nil {
 		log.Printf("Nie udało się zaktualizować wiersza w tabeli lifeguards, id wiersza: %d, błąd: %v\n", req.Id, err)
-		return nil, fmt.Errorf("Nie udało się zaktualizować wiersza w tabeli lifeguards: %w", err)
+		return nil, status.Error(codes.Internal, "Nie udało się zaktualizować wiersza w tabeli lifeguards")
 	}
 
 	log.Printf("Zaktualizowano wiersz w tabeli lifeguards, id wiersza: %d\n", req.Id)
@@ -65,14 +95,108 @@ func (s *server) UpdateLifeguard(ctx context.Context, req *UpdateLifeguardReques
 	return &UpdateLifeguardResponse{Success: true}, nil
 }
 
+func (s *server) PatchLifeguard(ctx context.Context, req *PatchLifeguardRequest) (*GetLifeguardResponse, error) {
+	if err := checkOwnMissionPatch(ctx, req); err != nil {
+		return nil, err
+	}
+
+	lifeguard, err := GetLifeguardByID(s.db, int(req.Id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Ratownik o ID %d nie znaleziony", req.Id)
+	}
+	if err != nil {
+		log.Printf("Nie udało się pobrać wiersza z tabeli lifeguards, id wiersza: %d, błąd: %v\n", req.Id, err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wiersza z tabeli lifeguards")
+	}
+
+	// The validation rules cannot require fields that may be left out, so blank values are rejected here.
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{{"name", req.Name}, {"login", req.Login}, {"password_hash", req.PasswordHash}} {
+		if field.value != nil && strings.TrimSpace(*field.value) == "" {
+			return nil, enumViolation(field.name, "Pole nie może być puste")
+		}
+	}
+	if req.Name != nil {
+		lifeguard.Name = *req.Name
+	}
+	if req.Login != nil {
+		lifeguard.Login = *req.Login
+	}
+	if req.PasswordHash != nil {
+		lifeguard.PasswordHash = *req.PasswordHash
+	}
+	if req.YearsOfExperience != nil {
+		lifeguard.YearsOfExperience = int(*req.YearsOfExperience)
+	}
+	if req.OnMission != nil {
+		lifeguard.OnMission = *req.OnMission
+	}
+	if req.Specialization != nil {
+		lifeguard.Specialization, err = resolveSpecialization(*req.Specialization, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = UpdateLifeguard(s.db, lifeguard.ID, lifeguard.Name, lifeguard.Login, lifeguard.PasswordHash, lifeguard.YearsOfExperience, lifeguard.Specialization, lifeguard.OnMission)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Ratownik o ID %d nie znaleziony", req.Id)
+	}
+	if err != nil {
+		log.Printf("Nie udało się zaktualizować wiersza w tabeli lifeguards, id wiersza: %d, błąd: %v\n", req.Id, err)
+		return nil, status.Error(codes.Internal, "Nie udało się zaktualizować wiersza w tabeli lifeguards")
+	}
+
+	log.Printf("Zaktualizowano wybrane pola wiersza w tabeli lifeguards, id wiersza: %d\n", req.Id)
+
+	return toLifeguardResponse(lifeguard), nil
+}
+
 func (s *server) DeleteLifeguard(ctx context.Context, req *DeleteLifeguardRequest) (*DeleteLifeguardResponse, error) {
 	err := DeleteLifeguard(s.db, int(req.Id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Ratownik o ID %d nie znaleziony", req.Id)
+	}
 	if err != nil {
 		log.Printf("Nie udało się usunąć wiersza z tabeli lifeguards, id wiersza: %d, błąd: %v\n", req.Id, err)
-		return nil, fmt.Errorf("Nie udało się usunąć wiersza z tabeli lifeguards: %w", err)
+		return nil, status.Error(codes.Internal, "Nie udało się usunąć wiersza z tabeli lifeguards")
 	}
 
 	log.Printf("Usunięto wiersz w tabeli lifeguards, id wiersza: %d\n", req.Id)
 
 	return &DeleteLifeguardResponse{Success: true}, nil
 }
+
+func (s *server) ListLifeguards(ctx context.Context, req *ListLifeguardsRequest) (*ListLifeguardsResponse, error) {
+	lifeguards, err := ListLifeguards(s.db)
+	if err != nil {
+		log.Printf("Nie udało się pobrać wierszy z tabeli lifeguards, błąd: %v\n", err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wierszy z tabeli lifeguards")
+	}
+
+	log.Printf("Pobrano %d wierszy z tabeli lifeguards\n", len(lifeguards))
+
+	response := &ListLifeguardsResponse{}
+	for i := range lifeguards {
+		response.Lifeguards = append(response.Lifeguards, toLifeguardResponse(&lifeguards[i]))
+	}
+	return response, nil
+}
+
+func (s *server) BatchGetLifeguards(ctx context.Context, req *BatchGetLifeguardsRequest) (*BatchGetLifeguardsResponse, error) {
+	lifeguards, err := GetLifeguardsByIDs(s.db, req.Ids)
+	if err != nil {
+		log.Printf("Nie udało się pobrać wierszy z tabeli lifeguards, id wierszy: %v, błąd: %v\n", req.Ids, err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wierszy z tabeli lifeguards")
+	}
+
+	log.Printf("Pobrano %d z %d żądanych wierszy z tabeli lifeguards\n", len(lifeguards), len(req.Ids))
+
+	response := &BatchGetLifeguardsResponse{}
+	for i := range lifeguards {
+		response.Lifeguards = append(response.Lifeguards, toLifeguardResponse(&lifeguards[i]))
+	}
+	return response, nil
+}
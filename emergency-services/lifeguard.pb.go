@@ -1,12 +1,14 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.14.0
 // source: lifeguard.proto
 
 package main
 
 import (
+	_ "github.com/szbobrowski/master-thesis/validation"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,6 +22,68 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The specialization of a lifeguard.
+type Specialization int32
+
+const (
+	Specialization_SPECIALIZATION_UNSPECIFIED   Specialization = 0
+	Specialization_SPECIALIZATION_BEACH         Specialization = 1
+	Specialization_SPECIALIZATION_POOL          Specialization = 2
+	Specialization_SPECIALIZATION_OPEN_WATER    Specialization = 3
+	Specialization_SPECIALIZATION_DIVER         Specialization = 4
+	Specialization_SPECIALIZATION_PARAMEDIC     Specialization = 5
+	Specialization_SPECIALIZATION_BOAT_OPERATOR Specialization = 6
+)
+
+// Enum value maps for Specialization.
+var (
+	Specialization_name = map[int32]string{
+		0: "SPECIALIZATION_UNSPECIFIED",
+		1: "SPECIALIZATION_BEACH",
+		2: "SPECIALIZATION_POOL",
+		3: "SPECIALIZATION_OPEN_WATER",
+		4: "SPECIALIZATION_DIVER",
+		5: "SPECIALIZATION_PARAMEDIC",
+		6: "SPECIALIZATION_BOAT_OPERATOR",
+	}
+	Specialization_value = map[string]int32{
+		"SPECIALIZATION_UNSPECIFIED":   0,
+		"SPECIALIZATION_BEACH":         1,
+		"SPECIALIZATION_POOL":          2,
+		"SPECIALIZATION_OPEN_WATER":    3,
+		"SPECIALIZATION_DIVER":         4,
+		"SPECIALIZATION_PARAMEDIC":     5,
+		"SPECIALIZATION_BOAT_OPERATOR": 6,
+	}
+)
+
+func (x Specialization) Enum() *Specialization {
+	p := new(Specialization)
+	*p = x
+	return p
+}
+
+func (x Specialization) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Specialization) Descriptor() protoreflect.EnumDescriptor {
+	return file_lifeguard_proto_enumTypes[0].Descriptor()
+}
+
+func (Specialization) Type() protoreflect.EnumType {
+	return &file_lifeguard_proto_enumTypes[0]
+}
+
+func (x Specialization) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Specialization.Descriptor instead.
+func (Specialization) EnumDescriptor() ([]byte, []int) {
+	return file_lifeguard_proto_rawDescGZIP(), []int{0}
+}
+
 // The request message containing the lifeguard details for creation.
 type CreateLifeguardRequest struct {
 	state         protoimpl.MessageState
@@ -30,8 +94,12 @@ type CreateLifeguardRequest struct {
 	Login             string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
 	PasswordHash      string `protobuf:"bytes,3,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
 	YearsOfExperience int32  `protobuf:"varint,4,opt,name=years_of_experience,json=yearsOfExperience,proto3" json:"years_of_experience,omitempty"`
-	Specialization    string `protobuf:"bytes,5,opt,name=specialization,proto3" json:"specialization,omitempty"`
-	OnMission         bool   `protobuf:"varint,6,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
+	// Free-text specialization accepted from older clients, used only when specialization is unspecified.
+	//
+	// Deprecated: Marked as deprecated in lifeguard.proto.
+	LegacySpecialization string         `protobuf:"bytes,5,opt,name=legacy_specialization,json=legacySpecialization,proto3" json:"legacy_specialization,omitempty"`
+	OnMission            bool           `protobuf:"varint,6,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
+	Specialization       Specialization `protobuf:"varint,7,opt,name=specialization,proto3,enum=main.Specialization" json:"specialization,omitempty"`
 }
 
 func (x *CreateLifeguardRequest) Reset() {
@@ -94,9 +162,10 @@ func (x *CreateLifeguardRequest) GetYearsOfExperience() int32 {
 	return 0
 }
 
-func (x *CreateLifeguardRequest) GetSpecialization() string {
+// Deprecated: Marked as deprecated in lifeguard.proto.
+func (x *CreateLifeguardRequest) GetLegacySpecialization() string {
 	if x != nil {
-		return x.Specialization
+		return x.LegacySpecialization
 	}
 	return ""
 }
@@ -108,6 +177,13 @@ func (x *CreateLifeguardRequest) GetOnMission() bool {
 	return false
 }
 
+func (x *CreateLifeguardRequest) GetSpecialization() Specialization {
+	if x != nil {
+		return x.Specialization
+	}
+	return Specialization_SPECIALIZATION_UNSPECIFIED
+}
+
 // The response message containing the ID of the newly created lifeguard.
 type CreateLifeguardResponse struct {
 	state         protoimpl.MessageState
@@ -215,9 +291,13 @@ type GetLifeguardResponse struct {
 	Login             string `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
 	PasswordHash      string `protobuf:"bytes,4,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
 	YearsOfExperience int32  `protobuf:"varint,5,opt,name=years_of_experience,json=yearsOfExperience,proto3" json:"years_of_experience,omitempty"`
-	Specialization    string `protobuf:"bytes,6,opt,name=specialization,proto3" json:"specialization,omitempty"`
-	OnMission         bool   `protobuf:"varint,7,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
-	CreatedAt         string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // You can use string or google.protobuf.Timestamp
+	// The specialization exactly as stored, kept for older clients and for values that cannot be normalized.
+	//
+	// Deprecated: Marked as deprecated in lifeguard.proto.
+	LegacySpecialization string         `protobuf:"bytes,6,opt,name=legacy_specialization,json=legacySpecialization,proto3" json:"legacy_specialization,omitempty"`
+	OnMission            bool           `protobuf:"varint,7,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
+	CreatedAt            string         `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // You can use string or google.protobuf.Timestamp
+	Specialization       Specialization `protobuf:"varint,9,opt,name=specialization,proto3,enum=main.Specialization" json:"specialization,omitempty"`
 }
 
 func (x *GetLifeguardResponse) Reset() {
@@ -287,9 +367,10 @@ func (x *GetLifeguardResponse) GetYearsOfExperience() int32 {
 	return 0
 }
 
-func (x *GetLifeguardResponse) GetSpecialization() string {
+// Deprecated: Marked as deprecated in lifeguard.proto.
+func (x *GetLifeguardResponse) GetLegacySpecialization() string {
 	if x != nil {
-		return x.Specialization
+		return x.LegacySpecialization
 	}
 	return ""
 }
@@ -308,6 +389,13 @@ func (x *GetLifeguardResponse) GetCreatedAt() string {
 	return ""
 }
 
+func (x *GetLifeguardResponse) GetSpecialization() Specialization {
+	if x != nil {
+		return x.Specialization
+	}
+	return Specialization_SPECIALIZATION_UNSPECIFIED
+}
+
 // The request message containing the lifeguard details for updating.
 type UpdateLifeguardRequest struct {
 	state         protoimpl.MessageState
@@ -319,8 +407,12 @@ type UpdateLifeguardRequest struct {
 	Login             string `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
 	PasswordHash      string `protobuf:"bytes,4,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
 	YearsOfExperience int32  `protobuf:"varint,5,opt,name=years_of_experience,json=yearsOfExperience,proto3" json:"years_of_experience,omitempty"`
-	Specialization    string `protobuf:"bytes,6,opt,name=specialization,proto3" json:"specialization,omitempty"`
-	OnMission         bool   `protobuf:"varint,7,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
+	// Free-text specialization accepted from older clients, used only when specialization is unspecified.
+	//
+	// Deprecated: Marked as deprecated in lifeguard.proto.
+	LegacySpecialization string         `protobuf:"bytes,6,opt,name=legacy_specialization,json=legacySpecialization,proto3" json:"legacy_specialization,omitempty"`
+	OnMission            bool           `protobuf:"varint,7,opt,name=on_mission,json=onMission,proto3" json:"on_mission,omitempty"`
+	Specialization       Specialization `protobuf:"varint,8,opt,name=specialization,proto3,enum=main.Specialization" json:"specialization,omitempty"`
 }
 
 func (x *UpdateLifeguardRequest) Reset() {
@@ -390,9 +482,10 @@ func (x *UpdateLifeguardRequest) GetYearsOfExperience() int32 {
 	return 0
 }
 
-func (x *UpdateLifeguardRequest) GetSpecialization() string {
+// Deprecated: Marked as deprecated in lifeguard.proto.
+func (x *UpdateLifeguardRequest) GetLegacySpecialization() string {
 	if x != nil {
-		return x.Specialization
+		return x.LegacySpecialization
 	}
 	return ""
 }
@@ -404,6 +497,109 @@ func (x *UpdateLifeguardRequest) GetOnMission() bool {
 	return false
 }
 
+func (x *UpdateLifeguardRequest) GetSpecialization() Specialization {
+	if x != nil {
+		return x.Specialization
+	}
+	return Specialization_SPECIALIZATION_UNSPECIFIED
+}
+
+// The request message containing the lifeguard fields to change, the others keep their values.
+type PatchLifeguardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              *string         `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Login             *string         `protobuf:"bytes,3,opt,name=login,proto3,oneof" json:"login,omitempty"`
+	PasswordHash      *string         `protobuf:"bytes,4,opt,name=password_hash,json=passwordHash,proto3,oneof" json:"password_hash,omitempty"`
+	YearsOfExperience *int32          `protobuf:"varint,5,opt,name=years_of_experience,json=yearsOfExperience,proto3,oneof" json:"years_of_experience,omitempty"`
+	OnMission         *bool           `protobuf:"varint,6,opt,name=on_mission,json=onMission,proto3,oneof" json:"on_mission,omitempty"`
+	Specialization    *Specialization `protobuf:"varint,7,opt,name=specialization,proto3,enum=main.Specialization,oneof" json:"specialization,omitempty"`
+}
+
+func (x *PatchLifeguardRequest) Reset() {
+	*x = PatchLifeguardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lifeguard_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchLifeguardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchLifeguardRequest) ProtoMessage() {}
+
+func (x *PatchLifeguardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lifeguard_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchLifeguardRequest.ProtoReflect.Descriptor instead.
+func (*PatchLifeguardRequest) Descriptor() ([]byte, []int) {
+	return file_lifeguard_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PatchLifeguardRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchLifeguardRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PatchLifeguardRequest) GetLogin() string {
+	if x != nil && x.Login != nil {
+		return *x.Login
+	}
+	return ""
+}
+
+func (x *PatchLifeguardRequest) GetPasswordHash() string {
+	if x != nil && x.PasswordHash != nil {
+		return *x.PasswordHash
+	}
+	return ""
+}
+
+func (x *PatchLifeguardRequest) GetYearsOfExperience() int32 {
+	if x != nil && x.YearsOfExperience != nil {
+		return *x.YearsOfExperience
+	}
+	return 0
+}
+
+func (x *PatchLifeguardRequest) GetOnMission() bool {
+	if x != nil && x.OnMission != nil {
+		return *x.OnMission
+	}
+	return false
+}
+
+func (x *PatchLifeguardRequest) GetSpecialization() Specialization {
+	if x != nil && x.Specialization != nil {
+		return *x.Specialization
+	}
+	return Specialization_SPECIALIZATION_UNSPECIFIED
+}
+
 // The response message confirming the lifeguard update.
 type UpdateLifeguardResponse struct {
 	state         protoimpl.MessageState
@@ -416,7 +612,7 @@ type UpdateLifeguardResponse struct {
 func (x *UpdateLifeguardResponse) Reset() {
 	*x = UpdateLifeguardResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lifeguard_proto_msgTypes[5]
+		mi := &file_lifeguard_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -429,7 +625,7 @@ func (x *UpdateLifeguardResponse) String() string {
 func (*UpdateLifeguardResponse) ProtoMessage() {}
 
 func (x *UpdateLifeguardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lifeguard_proto_msgTypes[5]
+	mi := &file_lifeguard_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +638,7 @@ func (x *UpdateLifeguardResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLifeguardResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLifeguardResponse) Descriptor() ([]byte, []int) {
-	return file_lifeguard_proto_rawDescGZIP(), []int{5}
+	return file_lifeguard_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateLifeguardResponse) GetSuccess() bool {
@@ -464,7 +660,7 @@ type DeleteLifeguardRequest struct {
 func (x *DeleteLifeguardRequest) Reset() {
 	*x = DeleteLifeguardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lifeguard_proto_msgTypes[6]
+		mi := &file_lifeguard_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -477,7 +673,7 @@ func (x *DeleteLifeguardRequest) String() string {
 func (*DeleteLifeguardRequest) ProtoMessage() {}
 
 func (x *DeleteLifeguardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lifeguard_proto_msgTypes[6]
+	mi := &file_lifeguard_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,7 +686,7 @@ func (x *DeleteLifeguardRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLifeguardRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLifeguardRequest) Descriptor() ([]byte, []int) {
-	return file_lifeguard_proto_rawDescGZIP(), []int{6}
+	return file_lifeguard_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteLifeguardRequest) GetId() int64 {
@@ -512,7 +708,7 @@ type DeleteLifeguardResponse struct {
 func (x *DeleteLifeguardResponse) Reset() {
 	*x = DeleteLifeguardResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lifeguard_proto_msgTypes[7]
+		mi := &file_lifeguard_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -525,7 +721,7 @@ func (x *DeleteLifeguardResponse) String() string {
 func (*DeleteLifeguardResponse) ProtoMessage() {}
 
 func (x *DeleteLifeguardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lifeguard_proto_msgTypes[7]
+	mi := &file_lifeguard_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +734,7 @@ func (x *DeleteLifeguardResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLifeguardResponse.ProtoReflect.Descriptor instead.
 func (*DeleteLifeguardResponse) Descriptor() ([]byte, []int) {
-	return file_lifeguard_proto_rawDescGZIP(), []int{7}
+	return file_lifeguard_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteLifeguardResponse) GetSuccess() bool {
@@ -548,93 +744,385 @@ func (x *DeleteLifeguardResponse) GetSuccess() bool {
 	return false
 }
 
+// The request message for listing lifeguards.
+type ListLifeguardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLifeguardsRequest) Reset() {
+	*x = ListLifeguardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lifeguard_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLifeguardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLifeguardsRequest) ProtoMessage() {}
+
+func (x *ListLifeguardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lifeguard_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLifeguardsRequest.ProtoReflect.Descriptor instead.
+func (*ListLifeguardsRequest) Descriptor() ([]byte, []int) {
+	return file_lifeguard_proto_rawDescGZIP(), []int{9}
+}
+
+// The response message containing the details of all lifeguards.
+type ListLifeguardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lifeguards []*GetLifeguardResponse `protobuf:"bytes,1,rep,name=lifeguards,proto3" json:"lifeguards,omitempty"`
+}
+
+func (x *ListLifeguardsResponse) Reset() {
+	*x = ListLifeguardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lifeguard_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLifeguardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLifeguardsResponse) ProtoMessage() {}
+
+func (x *ListLifeguardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lifeguard_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLifeguardsResponse.ProtoReflect.Descriptor instead.
+func (*ListLifeguardsResponse) Descriptor() ([]byte, []int) {
+	return file_lifeguard_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListLifeguardsResponse) GetLifeguards() []*GetLifeguardResponse {
+	if x != nil {
+		return x.Lifeguards
+	}
+	return nil
+}
+
+// The request message containing the IDs of the lifeguards to retrieve.
+type BatchGetLifeguardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchGetLifeguardsRequest) Reset() {
+	*x = BatchGetLifeguardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lifeguard_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetLifeguardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetLifeguardsRequest) ProtoMessage() {}
+
+func (x *BatchGetLifeguardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lifeguard_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetLifeguardsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetLifeguardsRequest) Descriptor() ([]byte, []int) {
+	return file_lifeguard_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchGetLifeguardsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// The response message containing the lifeguards found, in no particular order. Unknown IDs are left out.
+type BatchGetLifeguardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lifeguards []*GetLifeguardResponse `protobuf:"bytes,1,rep,name=lifeguards,proto3" json:"lifeguards,omitempty"`
+}
+
+func (x *BatchGetLifeguardsResponse) Reset() {
+	*x = BatchGetLifeguardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lifeguard_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetLifeguardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetLifeguardsResponse) ProtoMessage() {}
+
+func (x *BatchGetLifeguardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lifeguard_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetLifeguardsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetLifeguardsResponse) Descriptor() ([]byte, []int) {
+	return file_lifeguard_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchGetLifeguardsResponse) GetLifeguards() []*GetLifeguardResponse {
+	if x != nil {
+		return x.Lifeguards
+	}
+	return nil
+}
+
 var File_lifeguard_proto protoreflect.FileDescriptor
 
 var file_lifeguard_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x04, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x6f, 0x12, 0x04, 0x6d, 0x61, 0x69, 0x6e, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x2e, 0x0a, 0x13, 0x79, 0x65, 0x61, 0x72, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
-	0x79, 0x65, 0x61, 0x72, 0x73, 0x4f, 0x66, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f,
-	0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8b, 0x02, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x0a,
-	0x0d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x79, 0x65, 0x61, 0x72, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x65,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x11, 0x79, 0x65, 0x61, 0x72, 0x73, 0x4f, 0x66, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e,
-	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x0a,
-	0x0d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x79, 0x65, 0x61, 0x72, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x65,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xc2, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x20, 0x32, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x2b, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52,
+	0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a,
+	0x13, 0x79, 0x65, 0x61, 0x72, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04,
+	0x10, 0x00, 0x18, 0x50, 0x52, 0x11, 0x79, 0x65, 0x61, 0x72, 0x73, 0x4f, 0x66, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x15, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x64, 0x18, 0x01,
+	0x52, 0x14, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6e, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66,
+	0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0xda, 0x02,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x79, 0x65, 0x61, 0x72, 0x73, 0x5f, 0x6f,
+	0x66, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x79, 0x65, 0x61, 0x72, 0x73, 0x4f, 0x66, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x15, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x14, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0e,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf1, 0x02, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18,
+	0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04,
+	0x08, 0x01, 0x20, 0x32, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x0d, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x13, 0x79, 0x65, 0x61, 0x72,
+	0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x00, 0x18, 0x50, 0x52,
 	0x11, 0x79, 0x65, 0x61, 0x72, 0x73, 0x4f, 0x66, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e,
-	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x17, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x28,
-	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0xc9, 0x02,
-	0x0a, 0x10, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61,
+	0x63, 0x65, 0x12, 0x3d, 0x0a, 0x15, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x64, 0x18, 0x01, 0x52, 0x14, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2,
+	0x03, 0x0a, 0x15, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xc2, 0xf3, 0x18, 0x02, 0x20, 0x64, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x32, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x3d,
+	0x0a, 0x13, 0x79, 0x65, 0x61, 0x72, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xf3, 0x18,
+	0x04, 0x10, 0x00, 0x18, 0x50, 0x48, 0x03, 0x52, 0x11, 0x79, 0x65, 0x61, 0x72, 0x73, 0x4f, 0x66,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x04, 0x52, 0x09, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x05, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x79, 0x65,
+	0x61, 0x72, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66,
+	0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06,
+	0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x22, 0x39,
+	0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xc2, 0xf3, 0x18, 0x06, 0x08, 0x01,
+	0x10, 0x01, 0x38, 0x64, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x1a, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x69, 0x66, 0x65, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x73, 0x2a, 0xdc, 0x01, 0x0a, 0x0e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x50, 0x45, 0x43, 0x49, 0x41,
+	0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x50, 0x45, 0x43, 0x49, 0x41,
+	0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x48, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x53, 0x50, 0x45, 0x43, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
+	0x5f, 0x57, 0x41, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x56, 0x45, 0x52,
+	0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x50, 0x45, 0x43, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x44, 0x49, 0x43, 0x10, 0x05,
+	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x50, 0x45, 0x43, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x42, 0x4f, 0x41, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x10, 0x06, 0x32, 0x88, 0x06, 0x0a, 0x10, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x69, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a,
+	0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x32, 0x2f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x62, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61,
 	0x72, 0x64, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66,
 	0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
 	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x6d,
-	0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x69,
-	0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x6d,
-	0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x69,
-	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x12, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6e, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01,
+	0x2a, 0x1a, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x69, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4c,
+	0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x32, 0x13, 0x2f, 0x76,
+	0x32, 0x2f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x6b, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x6c,
+	0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x63,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x32, 0x2f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x78, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x66, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x32, 0x2f, 0x6c, 0x69, 0x66, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -649,31 +1137,50 @@ func file_lifeguard_proto_rawDescGZIP() []byte {
 	return file_lifeguard_proto_rawDescData
 }
 
-var file_lifeguard_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_lifeguard_proto_goTypes = []interface{}{
-	(*CreateLifeguardRequest)(nil),  // 0: main.CreateLifeguardRequest
-	(*CreateLifeguardResponse)(nil), // 1: main.CreateLifeguardResponse
-	(*GetLifeguardRequest)(nil),     // 2: main.GetLifeguardRequest
-	(*GetLifeguardResponse)(nil),    // 3: main.GetLifeguardResponse
-	(*UpdateLifeguardRequest)(nil),  // 4: main.UpdateLifeguardRequest
-	(*UpdateLifeguardResponse)(nil), // 5: main.UpdateLifeguardResponse
-	(*DeleteLifeguardRequest)(nil),  // 6: main.DeleteLifeguardRequest
-	(*DeleteLifeguardResponse)(nil), // 7: main.DeleteLifeguardResponse
+var file_lifeguard_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lifeguard_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_lifeguard_proto_goTypes = []any{
+	(Specialization)(0),                // 0: main.Specialization
+	(*CreateLifeguardRequest)(nil),     // 1: main.CreateLifeguardRequest
+	(*CreateLifeguardResponse)(nil),    // 2: main.CreateLifeguardResponse
+	(*GetLifeguardRequest)(nil),        // 3: main.GetLifeguardRequest
+	(*GetLifeguardResponse)(nil),       // 4: main.GetLifeguardResponse
+	(*UpdateLifeguardRequest)(nil),     // 5: main.UpdateLifeguardRequest
+	(*PatchLifeguardRequest)(nil),      // 6: main.PatchLifeguardRequest
+	(*UpdateLifeguardResponse)(nil),    // 7: main.UpdateLifeguardResponse
+	(*DeleteLifeguardRequest)(nil),     // 8: main.DeleteLifeguardRequest
+	(*DeleteLifeguardResponse)(nil),    // 9: main.DeleteLifeguardResponse
+	(*ListLifeguardsRequest)(nil),      // 10: main.ListLifeguardsRequest
+	(*ListLifeguardsResponse)(nil),     // 11: main.ListLifeguardsResponse
+	(*BatchGetLifeguardsRequest)(nil),  // 12: main.BatchGetLifeguardsRequest
+	(*BatchGetLifeguardsResponse)(nil), // 13: main.BatchGetLifeguardsResponse
 }
 var file_lifeguard_proto_depIdxs = []int32{
-	0, // 0: main.LifeguardService.CreateLifeguard:input_type -> main.CreateLifeguardRequest
-	2, // 1: main.LifeguardService.GetLifeguard:input_type -> main.GetLifeguardRequest
-	4, // 2: main.LifeguardService.UpdateLifeguard:input_type -> main.UpdateLifeguardRequest
-	6, // 3: main.LifeguardService.DeleteLifeguard:input_type -> main.DeleteLifeguardRequest
-	1, // 4: main.LifeguardService.CreateLifeguard:output_type -> main.CreateLifeguardResponse
-	3, // 5: main.LifeguardService.GetLifeguard:output_type -> main.GetLifeguardResponse
-	5, // 6: main.LifeguardService.UpdateLifeguard:output_type -> main.UpdateLifeguardResponse
-	7, // 7: main.LifeguardService.DeleteLifeguard:output_type -> main.DeleteLifeguardResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: main.CreateLifeguardRequest.specialization:type_name -> main.Specialization
+	0,  // 1: main.GetLifeguardResponse.specialization:type_name -> main.Specialization
+	0,  // 2: main.UpdateLifeguardRequest.specialization:type_name -> main.Specialization
+	0,  // 3: main.PatchLifeguardRequest.specialization:type_name -> main.Specialization
+	4,  // 4: main.ListLifeguardsResponse.lifeguards:type_name -> main.GetLifeguardResponse
+	4,  // 5: main.BatchGetLifeguardsResponse.lifeguards:type_name -> main.GetLifeguardResponse
+	1,  // 6: main.LifeguardService.CreateLifeguard:input_type -> main.CreateLifeguardRequest
+	3,  // 7: main.LifeguardService.GetLifeguard:input_type -> main.GetLifeguardRequest
+	5,  // 8: main.LifeguardService.UpdateLifeguard:input_type -> main.UpdateLifeguardRequest
+	6,  // 9: main.LifeguardService.PatchLifeguard:input_type -> main.PatchLifeguardRequest
+	8,  // 10: main.LifeguardService.DeleteLifeguard:input_type -> main.DeleteLifeguardRequest
+	10, // 11: main.LifeguardService.ListLifeguards:input_type -> main.ListLifeguardsRequest
+	12, // 12: main.LifeguardService.BatchGetLifeguards:input_type -> main.BatchGetLifeguardsRequest
+	2,  // 13: main.LifeguardService.CreateLifeguard:output_type -> main.CreateLifeguardResponse
+	4,  // 14: main.LifeguardService.GetLifeguard:output_type -> main.GetLifeguardResponse
+	7,  // 15: main.LifeguardService.UpdateLifeguard:output_type -> main.UpdateLifeguardResponse
+	4,  // 16: main.LifeguardService.PatchLifeguard:output_type -> main.GetLifeguardResponse
+	9,  // 17: main.LifeguardService.DeleteLifeguard:output_type -> main.DeleteLifeguardResponse
+	11, // 18: main.LifeguardService.ListLifeguards:output_type -> main.ListLifeguardsResponse
+	13, // 19: main.LifeguardService.BatchGetLifeguards:output_type -> main.BatchGetLifeguardsResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_lifeguard_proto_init() }
@@ -682,7 +1189,7 @@ func file_lifeguard_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_lifeguard_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_lifeguard_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateLifeguardRequest); i {
 			case 0:
 				return &v.state
@@ -694,7 +1201,7 @@ func file_lifeguard_proto_init() {
 				return nil
 			}
 		}
-		file_lifeguard_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_lifeguard_proto_msgTypes[1].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateLifeguardResponse); i {
 			case 0:
 				return &v.state
@@ -706,7 +1213,7 @@ func file_lifeguard_proto_init() {
 				return nil
 			}
 		}
-		file_lifeguard_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_lifeguard_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*GetLifeguardRequest); i {
 			case 0:
 				return &v.state
@@ -718,7 +1225,7 @@ func file_lifeguard_proto_init() {
 				return nil
 			}
 		}
-		file_lifeguard_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_lifeguard_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*GetLifeguardResponse); i {
 			case 0:
 				return &v.state
@@ -730,7 +1237,7 @@ func file_lifeguard_proto_init() {
 				return nil
 			}
 		}
-		file_lifeguard_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_lifeguard_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateLifeguardRequest); i {
 			case 0:
 				return &v.state
@@ -742,7 +1249,19 @@ func file_lifeguard_proto_init() {
 				return nil
 			}
 		}
-		file_lifeguard_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_lifeguard_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*PatchLifeguardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lifeguard_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateLifeguardResponse); i {
 			case 0:
 				return &v.state
@@ -754,7 +1273,7 @@ func file_lifeguard_proto_init() {
 				return nil
 			}
 		}
-		file_lifeguard_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_lifeguard_proto_msgTypes[7].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteLifeguardRequest); i {
 			case 0:
 				return &v.state
@@ -766,7 +1285,7 @@ func file_lifeguard_proto_init() {
 				return nil
 			}
 		}
-		file_lifeguard_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_lifeguard_proto_msgTypes[8].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteLifeguardResponse); i {
 			case 0:
 				return &v.state
@@ -778,19 +1297,69 @@ func file_lifeguard_proto_init() {
 				return nil
 			}
 		}
+		file_lifeguard_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLifeguardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lifeguard_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLifeguardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lifeguard_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetLifeguardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lifeguard_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetLifeguardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_lifeguard_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lifeguard_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_lifeguard_proto_goTypes,
 		DependencyIndexes: file_lifeguard_proto_depIdxs,
+		EnumInfos:         file_lifeguard_proto_enumTypes,
 		MessageInfos:      file_lifeguard_proto_msgTypes,
 	}.Build()
 	File_lifeguard_proto = out.File
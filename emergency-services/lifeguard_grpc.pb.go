@@ -24,8 +24,14 @@ type LifeguardServiceClient interface {
 	GetLifeguard(ctx context.Context, in *GetLifeguardRequest, opts ...grpc.CallOption) (*GetLifeguardResponse, error)
 	// Updates an existing lifeguard.
 	UpdateLifeguard(ctx context.Context, in *UpdateLifeguardRequest, opts ...grpc.CallOption) (*UpdateLifeguardResponse, error)
+	// Changes only the fields present in the request and returns the updated lifeguard.
+	PatchLifeguard(ctx context.Context, in *PatchLifeguardRequest, opts ...grpc.CallOption) (*GetLifeguardResponse, error)
 	// Deletes a lifeguard by ID.
 	DeleteLifeguard(ctx context.Context, in *DeleteLifeguardRequest, opts ...grpc.CallOption) (*DeleteLifeguardResponse, error)
+	// Lists all lifeguards.
+	ListLifeguards(ctx context.Context, in *ListLifeguardsRequest, opts ...grpc.CallOption) (*ListLifeguardsResponse, error)
+	// Retrieves several lifeguards by ID in one call.
+	BatchGetLifeguards(ctx context.Context, in *BatchGetLifeguardsRequest, opts ...grpc.CallOption) (*BatchGetLifeguardsResponse, error)
 }
 
 type lifeguardServiceClient struct {
@@ -63,6 +69,15 @@ func (c *lifeguardServiceClient) UpdateLifeguard(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *lifeguardServiceClient) PatchLifeguard(ctx context.Context, in *PatchLifeguardRequest, opts ...grpc.CallOption) (*GetLifeguardResponse, error) {
+	out := new(GetLifeguardResponse)
+	err := c.cc.Invoke(ctx, "/main.LifeguardService/PatchLifeguard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lifeguardServiceClient) DeleteLifeguard(ctx context.Context, in *DeleteLifeguardRequest, opts ...grpc.CallOption) (*DeleteLifeguardResponse, error) {
 	out := new(DeleteLifeguardResponse)
 	err := c.cc.Invoke(ctx, "/main.LifeguardService/DeleteLifeguard", in, out, opts...)
@@ -72,6 +87,24 @@ func (c *lifeguardServiceClient) DeleteLifeguard(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *lifeguardServiceClient) ListLifeguards(ctx context.Context, in *ListLifeguardsRequest, opts ...grpc.CallOption) (*ListLifeguardsResponse, error) {
+	out := new(ListLifeguardsResponse)
+	err := c.cc.Invoke(ctx, "/main.LifeguardService/ListLifeguards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lifeguardServiceClient) BatchGetLifeguards(ctx context.Context, in *BatchGetLifeguardsRequest, opts ...grpc.CallOption) (*BatchGetLifeguardsResponse, error) {
+	out := new(BatchGetLifeguardsResponse)
+	err := c.cc.Invoke(ctx, "/main.LifeguardService/BatchGetLifeguards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LifeguardServiceServer is the server API for LifeguardService service.
 // All implementations must embed UnimplementedLifeguardServiceServer
 // for forward compatibility
@@ -82,8 +115,14 @@ type LifeguardServiceServer interface {
 	GetLifeguard(context.Context, *GetLifeguardRequest) (*GetLifeguardResponse, error)
 	// Updates an existing lifeguard.
 	UpdateLifeguard(context.Context, *UpdateLifeguardRequest) (*UpdateLifeguardResponse, error)
+	// Changes only the fields present in the request and returns the updated lifeguard.
+	PatchLifeguard(context.Context, *PatchLifeguardRequest) (*GetLifeguardResponse, error)
 	// Deletes a lifeguard by ID.
 	DeleteLifeguard(context.Context, *DeleteLifeguardRequest) (*DeleteLifeguardResponse, error)
+	// Lists all lifeguards.
+	ListLifeguards(context.Context, *ListLifeguardsRequest) (*ListLifeguardsResponse, error)
+	// Retrieves several lifeguards by ID in one call.
+	BatchGetLifeguards(context.Context, *BatchGetLifeguardsRequest) (*BatchGetLifeguardsResponse, error)
 	mustEmbedUnimplementedLifeguardServiceServer()
 }
 
@@ -100,9 +139,18 @@ func (UnimplementedLifeguardServiceServer) GetLifeguard(context.Context, *GetLif
 func (UnimplementedLifeguardServiceServer) UpdateLifeguard(context.Context, *UpdateLifeguardRequest) (*UpdateLifeguardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLifeguard not implemented")
 }
+func (UnimplementedLifeguardServiceServer) PatchLifeguard(context.Context, *PatchLifeguardRequest) (*GetLifeguardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchLifeguard not implemented")
+}
 func (UnimplementedLifeguardServiceServer) DeleteLifeguard(context.Context, *DeleteLifeguardRequest) (*DeleteLifeguardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLifeguard not implemented")
 }
+func (UnimplementedLifeguardServiceServer) ListLifeguards(context.Context, *ListLifeguardsRequest) (*ListLifeguardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLifeguards not implemented")
+}
+func (UnimplementedLifeguardServiceServer) BatchGetLifeguards(context.Context, *BatchGetLifeguardsRequest) (*BatchGetLifeguardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetLifeguards not implemented")
+}
 func (UnimplementedLifeguardServiceServer) mustEmbedUnimplementedLifeguardServiceServer() {}
 
 // UnsafeLifeguardServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +218,24 @@ func _LifeguardService_UpdateLifeguard_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LifeguardService_PatchLifeguard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchLifeguardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LifeguardServiceServer).PatchLifeguard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.LifeguardService/PatchLifeguard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LifeguardServiceServer).PatchLifeguard(ctx, req.(*PatchLifeguardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LifeguardService_DeleteLifeguard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteLifeguardRequest)
 	if err := dec(in); err != nil {
@@ -188,6 +254,42 @@ func _LifeguardService_DeleteLifeguard_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LifeguardService_ListLifeguards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLifeguardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LifeguardServiceServer).ListLifeguards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.LifeguardService/ListLifeguards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LifeguardServiceServer).ListLifeguards(ctx, req.(*ListLifeguardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LifeguardService_BatchGetLifeguards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetLifeguardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LifeguardServiceServer).BatchGetLifeguards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.LifeguardService/BatchGetLifeguards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LifeguardServiceServer).BatchGetLifeguards(ctx, req.(*BatchGetLifeguardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LifeguardService_ServiceDesc is the grpc.ServiceDesc for LifeguardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,10 +309,22 @@ var LifeguardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLifeguard",
 			Handler:    _LifeguardService_UpdateLifeguard_Handler,
 		},
+		{
+			MethodName: "PatchLifeguard",
+			Handler:    _LifeguardService_PatchLifeguard_Handler,
+		},
 		{
 			MethodName: "DeleteLifeguard",
 			Handler:    _LifeguardService_DeleteLifeguard_Handler,
 		},
+		{
+			MethodName: "ListLifeguards",
+			Handler:    _LifeguardService_ListLifeguards_Handler,
+		},
+		{
+			MethodName: "BatchGetLifeguards",
+			Handler:    _LifeguardService_BatchGetLifeguards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lifeguard.proto",
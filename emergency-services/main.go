@@ -1,14 +1,24 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 
+	"github.com/szbobrowski/master-thesis/auth"
+	"github.com/szbobrowski/master-thesis/auth/mtls"
+	"github.com/szbobrowski/master-thesis/validation"
 	grpc "google.golang.org/grpc"
 )
 
 func main() {
-	dataSourceName := "root:new_password@tcp(127.0.0.1:3306)/mydb"
+	signer, err := auth.SignerFromEnv()
+	if err != nil {
+		log.Fatalf("Nie udało się wczytać sekretu tokenów dostępu: %v", err)
+	}
+
+	// clientFoundRows makes UPDATE report matched rows, see requireRow.
+	dataSourceName := "root:new_password@tcp(127.0.0.1:3306)/mydb?clientFoundRows=true"
 	db, err := ConnectToDB(dataSourceName)
 	if err != nil {
 		log.Fatalf("Nie udało się nawiązać połączenia z bazą danych: %v", err)
@@ -17,15 +27,33 @@ func main() {
 
 	CreateLifeguardsTable(db)
 	CreateVehiclesTable(db)
+	CreateApiKeysTable(db)
+
+	if err := NormalizeLifeguardSpecializations(db); err != nil {
+		log.Fatalf("Nie udało się znormalizować specjalizacji ratowników: %v", err)
+	}
+	if err := NormalizeVehicleTypes(db); err != nil {
+		log.Fatalf("Nie udało się znormalizować typów pojazdów: %v", err)
+	}
+
+	tlsConfig, err := mtls.ConfigFromEnv("emergency-services", "client-handler")
+	if err != nil {
+		log.Fatalf("Niepoprawna konfiguracja TLS: %v", err)
+	}
+	creds, err := mtls.Load(context.Background(), tlsConfig)
+	if err != nil {
+		log.Fatalf("Nie udało się wczytać certyfikatów TLS: %v", err)
+	}
 
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("Nie udało się uruchomić serwera gRPC: %v", err)
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(creds.ServerOption(), grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(signer, permissions), validation.UnaryServerInterceptor()))
 	RegisterLifeguardServiceServer(s, NewLifeguardServer(db))
 	RegisterVehicleServiceServer(s, NewVehicleServer(db))
+	RegisterApiKeyServiceServer(s, NewApiKeyServer(db))
 
 	log.Printf("Serwer nasłuchuje na adresie %v", lis.Addr())
 	if err := s.Serve(lis); err != nil {
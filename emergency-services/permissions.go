@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+
+	"github.com/szbobrowski/master-thesis/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	staff    = auth.StaffRoles
+	managers = []auth.Role{auth.RoleSupervisor, auth.RoleAdmin}
+	admins   = []auth.Role{auth.RoleAdmin}
+)
+
+var permissions = auth.Permissions{
+	"/main.LifeguardService/CreateLifeguard":    managers,
+	"/main.LifeguardService/GetLifeguard":       staff,
+	"/main.LifeguardService/UpdateLifeguard":    {auth.RoleLifeguard, auth.RoleSupervisor, auth.RoleAdmin},
+	"/main.LifeguardService/PatchLifeguard":     {auth.RoleLifeguard, auth.RoleSupervisor, auth.RoleAdmin},
+	"/main.LifeguardService/DeleteLifeguard":    managers,
+	"/main.LifeguardService/ListLifeguards":     staff,
+	"/main.LifeguardService/BatchGetLifeguards": staff,
+
+	"/main.VehicleService/CreateVehicle":    managers,
+	"/main.VehicleService/GetVehicle":       staff,
+	"/main.VehicleService/UpdateVehicle":    {auth.RoleDispatcher, auth.RoleSupervisor, auth.RoleAdmin},
+	"/main.VehicleService/PatchVehicle":     {auth.RoleDispatcher, auth.RoleSupervisor, auth.RoleAdmin},
+	"/main.VehicleService/DeleteVehicle":    managers,
+	"/main.VehicleService/ListVehicles":     staff,
+	"/main.VehicleService/BatchGetVehicles": staff,
+
+	"/main.ApiKeyService/CreateApiKey":       admins,
+	"/main.ApiKeyService/RotateApiKey":       admins,
+	"/main.ApiKeyService/RevokeApiKey":       admins,
+	"/main.ApiKeyService/ListApiKeys":        admins,
+	"/main.ApiKeyService/AuthenticateApiKey": {auth.RoleService},
+}
+
+// A lifeguard may only report whether they are on a mission: the update has to target their own record
+// and leave every other field as it is.
+func (s *server) checkOwnMissionUpdate(ctx context.Context, req *UpdateLifeguardRequest, specialization string) error {
+	claims, _ := auth.FromContext(ctx)
+	if claims.Role != auth.RoleLifeguard {
+		return nil
+	}
+
+	if claims.LifeguardID == 0 || req.Id != claims.LifeguardID {
+		return status.Error(codes.PermissionDenied, "Ratownik może aktualizować wyłącznie własne dane")
+	}
+
+	current, err := GetLifeguardByID(s.db, int(req.Id))
+	if err != nil {
+		return err
+	}
+	if req.Name != current.Name || req.Login != current.Login || req.PasswordHash != current.PasswordHash ||
+		int(req.YearsOfExperience) != current.YearsOfExperience || specialization != current.Specialization {
+		return status.Error(codes.PermissionDenied, "Ratownik może zmienić wyłącznie pole on_mission")
+	}
+	return nil
+}
+
+// The same rule for a partial update: on_mission is the only field a lifeguard may send.
+func checkOwnMissionPatch(ctx context.Context, req *PatchLifeguardRequest) error {
+	claims, _ := auth.FromContext(ctx)
+	if claims.Role != auth.RoleLifeguard {
+		return nil
+	}
+
+	if claims.LifeguardID == 0 || req.Id != claims.LifeguardID {
+		return status.Error(codes.PermissionDenied, "Ratownik może aktualizować wyłącznie własne dane")
+	}
+	if req.Name != nil || req.Login != nil || req.PasswordHash != nil || req.YearsOfExperience != nil || req.Specialization != nil {
+		return status.Error(codes.PermissionDenied, "Ratownik może zmienić wyłącznie pole on_mission")
+	}
+	return nil
+}
@@ -3,9 +3,12 @@ package main
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"errors"
 	"log"
 	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func NewVehicleServer(db *sql.DB) *server {
@@ -17,10 +20,15 @@ func (s *server) mustEmbedUnimplementedVehicleServiceServer() {
 }
 
 func (s *server) CreateVehicle(ctx context.Context, req *CreateVehicleRequest) (*CreateVehicleResponse, error) {
-	id, err := CreateVehicle(s.db, req.Type, req.Location, int(req.FuelLevelInLiters), req.OnMission, int(req.LifeguardInChargeId))
+	vehicleType, err := resolveVehicleType(req.Type, req.LegacyType)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := CreateVehicle(s.db, vehicleType, req.Location, int(req.FuelLevelInLiters), req.OnMission, int(req.LifeguardInChargeId))
 	if err != nil {
 		log.Printf("Nie udało się utworzyć wiersza w tabeli vehicles: %v\n", err)
-		return nil, fmt.Errorf("Nie udało się utworzyć wiersza w tabeli vehicles: %w", err)
+		return nil, status.Error(codes.Internal, "Nie udało się utworzyć wiersza w tabeli vehicles")
 	}
 
 	log.Printf("Utworzono wiersz w tabeli vehicles, id wiersza: %d\n", id)
@@ -30,29 +38,47 @@ func (s *server) CreateVehicle(ctx context.Context, req *CreateVehicleRequest) (
 
 func (s *server) GetVehicle(ctx context.Context, req *GetVehicleRequest) (*GetVehicleResponse, error) {
 	vehicle, err := GetVehicleByID(s.db, int(req.Id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Pojazd o ID %d nie znaleziony", req.Id)
+	}
 	if err != nil {
 		log.Printf("Nie udało się pobrać wiersza z tabeli vehicles, id wiersza: %d, error: %v\n", req.Id, err)
-		return nil, fmt.Errorf("Nie udało się pobrać wiersza z tabeli vehicles: %w", err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wiersza z tabeli vehicles")
 	}
 
 	log.Printf("Pobrano wiersz w tabeli vehicles: %+v\n", vehicle)
 
+	return toVehicleResponse(vehicle), nil
+}
+
+func toVehicleResponse(vehicle *VehicleDTO) *GetVehicleResponse {
+	vehicleType, _ := parseVehicleType(vehicle.Type)
+
 	return &GetVehicleResponse{
 		Id:                  int64(vehicle.ID),
-		Type:                vehicle.Type,
+		LegacyType:          vehicle.Type,
 		Location:            vehicle.Location,
 		FuelLevelInLiters:   int32(vehicle.FuelLevelInLiters),
 		OnMission:           vehicle.OnMission,
 		LifeguardInChargeId: int64(vehicle.LifeguardInChargeID),
 		CreatedAt:           vehicle.CreatedAt.Format(time.RFC3339),
-	}, nil
+		Type:                vehicleType,
+	}
 }
 
 func (s *server) UpdateVehicle(ctx context.Context, req *UpdateVehicleRequest) (*UpdateVehicleResponse, error) {
-	err := UpdateVehicle(s.db, int(req.Id), req.Type, req.Location, int(req.FuelLevelInLiters), req.OnMission, int(req.LifeguardInChargeId))
+	vehicleType, err := resolveVehicleType(req.Type, req.LegacyType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = UpdateVehicle(s.db, int(req.Id), vehicleType, req.Location, int(req.FuelLevelInLiters), req.OnMission, int(req.LifeguardInChargeId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Pojazd o ID %d nie znaleziony", req.Id)
+	}
 	if err != nil {
 		log.Printf("Nie udało się zaktualizować wiersza w tabeli vehicles, id wiersza: %d, błąd: %v\n", req.Id, err)
-		return nil, fmt.Errorf("Nie udało się zaktualizować wiersza w tabeli vehicles: %w", err)
+		return nil, status.Error(codes.Internal, "Nie udało się zaktualizować wiersza w tabeli vehicles")
 	}
 
 	log.Printf("Zaktualizowano wiersz w tabeli vehicles, id wiersza: %d\n", req.Id)
@@ -60,14 +86,97 @@ func (s *server) UpdateVehicle(ctx context.Context, req *UpdateVehicleRequest) (
 	return &UpdateVehicleResponse{Success: true}, nil
 }
 
+func (s *server) PatchVehicle(ctx context.Context, req *PatchVehicleRequest) (*GetVehicleResponse, error) {
+	vehicle, err := GetVehicleByID(s.db, int(req.Id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Pojazd o ID %d nie znaleziony", req.Id)
+	}
+	if err != nil {
+		log.Printf("Nie udało się pobrać wiersza z tabeli vehicles, id wiersza: %d, error: %v\n", req.Id, err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wiersza z tabeli vehicles")
+	}
+
+	if req.Type != nil {
+		vehicle.Type, err = resolveVehicleType(*req.Type, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.Location != nil {
+		vehicle.Location = *req.Location
+	}
+	if req.FuelLevelInLiters != nil {
+		vehicle.FuelLevelInLiters = int(*req.FuelLevelInLiters)
+	}
+	if req.OnMission != nil {
+		vehicle.OnMission = *req.OnMission
+	}
+	if req.LifeguardInChargeId != nil {
+		vehicle.LifeguardInChargeID = int(*req.LifeguardInChargeId)
+	}
+
+	err = UpdateVehicle(s.db, vehicle.ID, vehicle.Type, vehicle.Location, vehicle.FuelLevelInLiters, vehicle.OnMission, vehicle.LifeguardInChargeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Pojazd o ID %d nie znaleziony", req.Id)
+	}
+	if err != nil {
+		log.Printf("Nie udało się zaktualizować wiersza w tabeli vehicles, id wiersza: %d, błąd: %v\n", req.Id, err)
+		return nil, status.Error(codes.Internal, "Nie udało się zaktualizować wiersza w tabeli vehicles")
+	}
+
+	log.Printf("Zaktualizowano wybrane pola wiersza w tabeli vehicles, id wiersza: %d\n", req.Id)
+
+	return toVehicleResponse(vehicle), nil
+}
+
 func (s *server) DeleteVehicle(ctx context.Context, req *DeleteVehicleRequest) (*DeleteVehicleResponse, error) {
 	err := DeleteVehicle(s.db, int(req.Id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "Pojazd o ID %d nie znaleziony", req.Id)
+	}
 	if err != nil {
 		log.Printf("Nie udało się usunąć wiersza z tabeli vehicles, id wiersza: %d, error: %v\n", req.Id, err)
-		return nil, fmt.Errorf("Nie udało się usunąć wiersza z tabeli vehicles: %w", err)
+		return nil, status.Error(codes.Internal, "Nie udało się usunąć wiersza z tabeli vehicles")
 	}
 
 	log.Printf("Usunięto wiersz z tabeli vehicles, id wiersza: %d\n", req.Id)
 
 	return &DeleteVehicleResponse{Success: true}, nil
 }
+
+func (s *server) ListVehicles(ctx context.Context, req *ListVehiclesRequest) (*ListVehiclesResponse, error) {
+	lifeguardInChargeIDs := req.LifeguardInChargeIds
+	if req.LifeguardInChargeId != 0 {
+		lifeguardInChargeIDs = append(lifeguardInChargeIDs, req.LifeguardInChargeId)
+	}
+
+	vehicles, err := ListVehicles(s.db, lifeguardInChargeIDs)
+	if err != nil {
+		log.Printf("Nie udało się pobrać wierszy z tabeli vehicles, error: %v\n", err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wierszy z tabeli vehicles")
+	}
+
+	log.Printf("Pobrano %d wierszy z tabeli vehicles\n", len(vehicles))
+
+	response := &ListVehiclesResponse{}
+	for i := range vehicles {
+		response.Vehicles = append(response.Vehicles, toVehicleResponse(&vehicles[i]))
+	}
+	return response, nil
+}
+
+func (s *server) BatchGetVehicles(ctx context.Context, req *BatchGetVehiclesRequest) (*BatchGetVehiclesResponse, error) {
+	vehicles, err := GetVehiclesByIDs(s.db, req.Ids)
+	if err != nil {
+		log.Printf("Nie udało się pobrać wierszy z tabeli vehicles, id wierszy: %v, error: %v\n", req.Ids, err)
+		return nil, status.Error(codes.Internal, "Nie udało się pobrać wierszy z tabeli vehicles")
+	}
+
+	log.Printf("Pobrano %d z %d żądanych wierszy z tabeli vehicles\n", len(vehicles), len(req.Ids))
+
+	response := &BatchGetVehiclesResponse{}
+	for i := range vehicles {
+		response.Vehicles = append(response.Vehicles, toVehicleResponse(&vehicles[i]))
+	}
+	return response, nil
+}
@@ -24,8 +24,14 @@ type VehicleServiceClient interface {
 	GetVehicle(ctx context.Context, in *GetVehicleRequest, opts ...grpc.CallOption) (*GetVehicleResponse, error)
 	// Updates an existing vehicle.
 	UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error)
+	// Changes only the fields present in the request and returns the updated vehicle.
+	PatchVehicle(ctx context.Context, in *PatchVehicleRequest, opts ...grpc.CallOption) (*GetVehicleResponse, error)
 	// Deletes a vehicle by ID.
 	DeleteVehicle(ctx context.Context, in *DeleteVehicleRequest, opts ...grpc.CallOption) (*DeleteVehicleResponse, error)
+	// Lists vehicles, optionally only those in charge of the given lifeguards.
+	ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error)
+	// Retrieves several vehicles by ID in one call.
+	BatchGetVehicles(ctx context.Context, in *BatchGetVehiclesRequest, opts ...grpc.CallOption) (*BatchGetVehiclesResponse, error)
 }
 
 type vehicleServiceClient struct {
@@ -63,6 +69,15 @@ func (c *vehicleServiceClient) UpdateVehicle(ctx context.Context, in *UpdateVehi
 	return out, nil
 }
 
+func (c *vehicleServiceClient) PatchVehicle(ctx context.Context, in *PatchVehicleRequest, opts ...grpc.CallOption) (*GetVehicleResponse, error) {
+	out := new(GetVehicleResponse)
+	err := c.cc.Invoke(ctx, "/main.VehicleService/PatchVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vehicleServiceClient) DeleteVehicle(ctx context.Context, in *DeleteVehicleRequest, opts ...grpc.CallOption) (*DeleteVehicleResponse, error) {
 	out := new(DeleteVehicleResponse)
 	err := c.cc.Invoke(ctx, "/main.VehicleService/DeleteVehicle", in, out, opts...)
@@ -72,6 +87,24 @@ func (c *vehicleServiceClient) DeleteVehicle(ctx context.Context, in *DeleteVehi
 	return out, nil
 }
 
+func (c *vehicleServiceClient) ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error) {
+	out := new(ListVehiclesResponse)
+	err := c.cc.Invoke(ctx, "/main.VehicleService/ListVehicles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) BatchGetVehicles(ctx context.Context, in *BatchGetVehiclesRequest, opts ...grpc.CallOption) (*BatchGetVehiclesResponse, error) {
+	out := new(BatchGetVehiclesResponse)
+	err := c.cc.Invoke(ctx, "/main.VehicleService/BatchGetVehicles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VehicleServiceServer is the server API for VehicleService service.
 // All implementations must embed UnimplementedVehicleServiceServer
 // for forward compatibility
@@ -82,8 +115,14 @@ type VehicleServiceServer interface {
 	GetVehicle(context.Context, *GetVehicleRequest) (*GetVehicleResponse, error)
 	// Updates an existing vehicle.
 	UpdateVehicle(context.Context, *UpdateVehicleRequest) (*UpdateVehicleResponse, error)
+	// Changes only the fields present in the request and returns the updated vehicle.
+	PatchVehicle(context.Context, *PatchVehicleRequest) (*GetVehicleResponse, error)
 	// Deletes a vehicle by ID.
 	DeleteVehicle(context.Context, *DeleteVehicleRequest) (*DeleteVehicleResponse, error)
+	// Lists vehicles, optionally only those in charge of the given lifeguards.
+	ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error)
+	// Retrieves several vehicles by ID in one call.
+	BatchGetVehicles(context.Context, *BatchGetVehiclesRequest) (*BatchGetVehiclesResponse, error)
 	mustEmbedUnimplementedVehicleServiceServer()
 }
 
@@ -100,9 +139,18 @@ func (UnimplementedVehicleServiceServer) GetVehicle(context.Context, *GetVehicle
 func (UnimplementedVehicleServiceServer) UpdateVehicle(context.Context, *UpdateVehicleRequest) (*UpdateVehicleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateVehicle not implemented")
 }
+func (UnimplementedVehicleServiceServer) PatchVehicle(context.Context, *PatchVehicleRequest) (*GetVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchVehicle not implemented")
+}
 func (UnimplementedVehicleServiceServer) DeleteVehicle(context.Context, *DeleteVehicleRequest) (*DeleteVehicleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVehicle not implemented")
 }
+func (UnimplementedVehicleServiceServer) ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVehicles not implemented")
+}
+func (UnimplementedVehicleServiceServer) BatchGetVehicles(context.Context, *BatchGetVehiclesRequest) (*BatchGetVehiclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetVehicles not implemented")
+}
 func (UnimplementedVehicleServiceServer) mustEmbedUnimplementedVehicleServiceServer() {}
 
 // UnsafeVehicleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +218,24 @@ func _VehicleService_UpdateVehicle_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VehicleService_PatchVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).PatchVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.VehicleService/PatchVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).PatchVehicle(ctx, req.(*PatchVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VehicleService_DeleteVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteVehicleRequest)
 	if err := dec(in); err != nil {
@@ -188,6 +254,42 @@ func _VehicleService_DeleteVehicle_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VehicleService_ListVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVehiclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).ListVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.VehicleService/ListVehicles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).ListVehicles(ctx, req.(*ListVehiclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_BatchGetVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetVehiclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).BatchGetVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.VehicleService/BatchGetVehicles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).BatchGetVehicles(ctx, req.(*BatchGetVehiclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VehicleService_ServiceDesc is the grpc.ServiceDesc for VehicleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,10 +309,22 @@ var VehicleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateVehicle",
 			Handler:    _VehicleService_UpdateVehicle_Handler,
 		},
+		{
+			MethodName: "PatchVehicle",
+			Handler:    _VehicleService_PatchVehicle_Handler,
+		},
 		{
 			MethodName: "DeleteVehicle",
 			Handler:    _VehicleService_DeleteVehicle_Handler,
 		},
+		{
+			MethodName: "ListVehicles",
+			Handler:    _VehicleService_ListVehicles_Handler,
+		},
+		{
+			MethodName: "BatchGetVehicles",
+			Handler:    _VehicleService_BatchGetVehicles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vehicle.proto",
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrUnsupportedSchemaVersion = errors.New("Nieobsługiwana wersja schematu zdarzenia")
+
+// Version 1 messages were flat JSON objects with an "operation" field instead of an envelope.
+type legacyMessage struct {
+	Operation       string `json:"operation"`
+	IncidentID      string `json:"incidentId"`
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	Status          string `json:"status"`
+	CreationDate    string `json:"creationDate"`
+	StatusChangedAt string `json:"statusChangedAt"`
+}
+
+var legacyOperations = map[string]EventType{
+	"CREATE":              IncidentCreated,
+	"UPDATE":              IncidentUpdated,
+	"DELETE":              IncidentDeleted,
+	"STATUS_ACKNOWLEDGED": IncidentAcknowledged,
+	"STATUS_DISPATCHED":   IncidentDispatched,
+	"STATUS_ON_SCENE":     IncidentOnScene,
+	"STATUS_RESOLVED":     IncidentResolved,
+	"STATUS_CLOSED":       IncidentClosed,
+	"STATUS_CANCELLED":    IncidentCancelled,
+}
+
+// Decode reads a message body of any supported schema version and returns it as an envelope of the current version.
+// Legacy messages have no event ID, time or source; SchemaVersion is set to the version they were decoded from.
+func Decode(body []byte) (Envelope, error) {
+	var probe struct {
+		SpecVersion   string `json:"specversion"`
+		SchemaVersion int    `json:"schemaversion"`
+	}
+	if err := json.Unmarshal(body, &probe); err != nil {
+		return Envelope{}, fmt.Errorf("Niepoprawny format JSON zdarzenia: %w", err)
+	}
+
+	if probe.SpecVersion == "" {
+		return decodeLegacy(body)
+	}
+
+	if probe.SchemaVersion > SchemaVersion {
+		return Envelope{}, fmt.Errorf("%w: %d", ErrUnsupportedSchemaVersion, probe.SchemaVersion)
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return Envelope{}, fmt.Errorf("Niepoprawny format zdarzenia: %w", err)
+	}
+	return envelope, nil
+}
+
+func decodeLegacy(body []byte) (Envelope, error) {
+	var message legacyMessage
+	if err := json.Unmarshal(body, &message); err != nil {
+		return Envelope{}, fmt.Errorf("Niepoprawny format zdarzenia w wersji 1: %w", err)
+	}
+
+	eventType, ok := legacyOperations[strings.ToUpper(message.Operation)]
+	if !ok {
+		return Envelope{}, fmt.Errorf("Nieznana operacja w zdarzeniu w wersji 1: %q", message.Operation)
+	}
+
+	return Envelope{
+		SpecVersion:     SpecVersion,
+		Type:            eventType,
+		DataContentType: DataContentType,
+		SchemaVersion:   1,
+		Data: IncidentData{
+			IncidentID:      message.IncidentID,
+			Title:           message.Title,
+			Description:     message.Description,
+			Status:          message.Status,
+			CreationDate:    message.CreationDate,
+			StatusChangedAt: message.StatusChangedAt,
+		},
+	}, nil
+}
@@ -0,0 +1,110 @@
+// Package events defines the incident events published to the IncidentsQueue SQS queue
+// by incident-notifier and consumed by incident-manager.
+//
+// An event is serialized as a CloudEvents 1.0 JSON document (structured content mode),
+// with the schema version of the data carried in the "schemaversion" extension attribute.
+package events
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	SchemaVersion   = 2
+	SpecVersion     = "1.0"
+	DataContentType = "application/json"
+)
+
+type EventType string
+
+const (
+	IncidentCreated      EventType = "incident.created"
+	IncidentUpdated      EventType = "incident.updated"
+	IncidentDeleted      EventType = "incident.deleted"
+	IncidentAcknowledged EventType = "incident.acknowledged"
+	IncidentDispatched   EventType = "incident.dispatched"
+	IncidentOnScene      EventType = "incident.on_scene"
+	IncidentResolved     EventType = "incident.resolved"
+	IncidentClosed       EventType = "incident.closed"
+	IncidentCancelled    EventType = "incident.cancelled"
+)
+
+type Envelope struct {
+	SpecVersion     string       `json:"specversion"`
+	ID              string       `json:"id"`
+	Type            EventType    `json:"type"`
+	Source          string       `json:"source"`
+	Time            time.Time    `json:"time"`
+	DataContentType string       `json:"datacontenttype"`
+	SchemaVersion   int          `json:"schemaversion"`
+	Data            IncidentData `json:"data"`
+}
+
+// Fields added within a schema version are optional, so older consumers can ignore them.
+type IncidentData struct {
+	IncidentID           string       `json:"incidentId"`
+	ShortCode            string       `json:"shortCode,omitempty"`
+	Title                string       `json:"title"`
+	Description          string       `json:"description"`
+	Status               string       `json:"status"`
+	CreationDate         string       `json:"creationDate"`
+	StatusChangedAt      string       `json:"statusChangedAt,omitempty"`
+	ResolutionNote       string       `json:"resolutionNote,omitempty"`
+	CancellationReason   string       `json:"cancellationReason,omitempty"`
+	Severity             string       `json:"severity,omitempty"`
+	Priority             int32        `json:"priority,omitempty"`
+	Category             string       `json:"category,omitempty"`
+	Coordinates          *Coordinates `json:"coordinates,omitempty"`
+	Zone                 string       `json:"zone,omitempty"`
+	Reporter             *Reporter    `json:"reporter,omitempty"`
+	PeopleInvolved       int32        `json:"peopleInvolved,omitempty"`
+	AssignedLifeguardIDs []int64      `json:"assignedLifeguardIds,omitempty"`
+	AssignedVehicleIDs   []int64      `json:"assignedVehicleIds,omitempty"`
+}
+
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type Reporter struct {
+	Name  string `json:"name,omitempty"`
+	Phone string `json:"phone,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// NewIncidentEvent creates an event of the current schema version. The producer becomes the CloudEvents source.
+func NewIncidentEvent(eventType EventType, producer string, data IncidentData) (Envelope, error) {
+	id, err := newEventID()
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{
+		SpecVersion:     SpecVersion,
+		ID:              id,
+		Type:            eventType,
+		Source:          producer,
+		Time:            time.Now().UTC(),
+		DataContentType: DataContentType,
+		SchemaVersion:   SchemaVersion,
+		Data:            data,
+	}, nil
+}
+
+func (e Envelope) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+func newEventID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("Nie udało się wygenerować identyfikatora zdarzenia: %w", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
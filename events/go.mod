@@ -0,0 +1,3 @@
+module github.com/szbobrowski/master-thesis/events
+
+go 1.22.5
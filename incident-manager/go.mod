@@ -2,7 +2,10 @@ module github.com/szbobrowski/master-thesis/incident-manager
 
 go 1.23.0
 
-require github.com/aws/aws-sdk-go-v2/service/sqs v1.34.6
+require (
+	github.com/aws/aws-sdk-go-v2/service/sqs v1.34.6
+	github.com/szbobrowski/master-thesis/events v0.0.0
+)
 
 require (
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.30 // indirect
@@ -16,9 +19,11 @@ require (
 )
 
 require (
-	github.com/aws/aws-sdk-go-v2 v1.30.4 // indirect
+	github.com/aws/aws-sdk-go-v2 v1.30.4
 	github.com/aws/aws-sdk-go-v2/config v1.27.31
 	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.16 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.16 // indirect
 	github.com/aws/smithy-go v1.20.4 // indirect
 )
+
+replace github.com/szbobrowski/master-thesis/events => ../events
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/szbobrowski/master-thesis/events"
 )
 
 type SQSConsumer struct {
@@ -79,7 +80,17 @@ func (c *SQSConsumer) StartPolling() {
 }
 
 func (c *SQSConsumer) processMessage(message types.Message) error {
-	fmt.Printf("Otrzymano wiadomość: %s\n", *message.Body)
+	if message.Body == nil {
+		return fmt.Errorf("Wiadomość %s nie ma treści", aws.ToString(message.MessageId))
+	}
+
+	event, err := events.Decode([]byte(*message.Body))
+	if err != nil {
+		return fmt.Errorf("Nie udało się odczytać zdarzenia z wiadomości %s: %v", aws.ToString(message.MessageId), err)
+	}
+
+	fmt.Printf("Otrzymano zdarzenie %s (ID: %s, wersja schematu: %d) dla incydentu %s, status: %s\n",
+		event.Type, event.ID, event.SchemaVersion, event.Data.IncidentID, event.Data.Status)
 	return nil
 }
 
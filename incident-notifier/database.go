@@ -4,18 +4,79 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
 
+// Field names are the DynamoDB attribute names.
 type Incident struct {
-	IncidentID   string
-	Title        string
-	Description  string
-	Status       string
-	CreationDate string
+	IncidentID           string
+	ShortCode            string `dynamodbav:",omitempty"`
+	ShortCodeReserved    bool   `dynamodbav:",omitempty"`
+	Title                string
+	Description          string
+	Status               string
+	CreationDate         string
+	StatusChangedAt      string   `dynamodbav:",omitempty"`
+	AcknowledgedAt       string   `dynamodbav:",omitempty"`
+	DispatchedAt         string   `dynamodbav:",omitempty"`
+	OnSceneAt            string   `dynamodbav:",omitempty"`
+	ResolvedAt           string   `dynamodbav:",omitempty"`
+	ClosedAt             string   `dynamodbav:",omitempty"`
+	CancelledAt          string   `dynamodbav:",omitempty"`
+	ResolutionNote       string   `dynamodbav:",omitempty"`
+	CancellationReason   string   `dynamodbav:",omitempty"`
+	Severity             string   `dynamodbav:",omitempty"`
+	Priority             int32    `dynamodbav:",omitempty"`
+	Category             string   `dynamodbav:",omitempty"`
+	Latitude             *float64 `dynamodbav:",omitempty"`
+	Longitude            *float64 `dynamodbav:",omitempty"`
+	Zone                 string   `dynamodbav:",omitempty"`
+	ReporterName         string   `dynamodbav:",omitempty"`
+	ReporterPhone        string   `dynamodbav:",omitempty"`
+	ReporterEmail        string   `dynamodbav:",omitempty"`
+	PeopleInvolved       int32    `dynamodbav:",omitempty"`
+	AssignedLifeguardIDs []int64  `dynamodbav:",omitempty"`
+	AssignedVehicleIDs   []int64  `dynamodbav:",omitempty"`
+}
+
+const (
+	statusIndexName       = "StatusCreationDateIndex"
+	creationDateIndexName = "EntityTypeCreationDateIndex"
+	incidentEntityType    = "INCIDENT"
+	// Fixed-width UTC timestamps sort chronologically as strings, unlike RFC 3339 with optional fractions.
+	sortableTimeFormat = "2006-01-02T15:04:05.000000Z"
+
+	// Building an index over an existing table takes time proportional to its size.
+	tableActivePollInterval = 5 * time.Second
+	tableActiveTimeout      = 30 * time.Minute
+)
+
+var (
+	errIncidentNotFound    = errors.New("Nie znaleziono incydentu")
+	errMalformedIncident   = errors.New("Niepoprawny format zapisanego incydentu")
+	errStatusChanged       = errors.New("Status incydentu został zmieniony przez inne żądanie")
+	errIdempotencyKeyTaken = errors.New("Klucz idempotencji jest już przypisany do incydentu")
+	errIncidentIDTaken     = errors.New("Incydent o wygenerowanym identyfikatorze już istnieje")
+)
+
+type IncidentFilter struct {
+	Status      string
+	CreatedFrom string
+	CreatedTo   string
+}
+
+func optionalString(item map[string]types.AttributeValue, name string) string {
+	if value, ok := item[name].(*types.AttributeValueMemberS); ok {
+		return value.Value
+	}
+	return ""
 }
 
 func tableExists(client *dynamodb.Client) (bool, error) {
@@ -32,21 +93,58 @@ func tableExists(client *dynamodb.Client) (bool, error) {
 	return true, nil
 }
 
+func attributeDefinitions() []types.AttributeDefinition {
+	var definitions []types.AttributeDefinition
+	for _, name := range []string{"IncidentID", "Status", "CreationDate", "EntityType"} {
+		definitions = append(definitions, types.AttributeDefinition{
+			AttributeName: aws.String(name),
+			AttributeType: types.ScalarAttributeTypeS,
+		})
+	}
+	return definitions
+}
+
+func indexDefinitions() []types.GlobalSecondaryIndex {
+	index := func(name, partitionKey string) types.GlobalSecondaryIndex {
+		return types.GlobalSecondaryIndex{
+			IndexName: aws.String(name),
+			KeySchema: []types.KeySchemaElement{
+				{
+					AttributeName: aws.String(partitionKey),
+					KeyType:       types.KeyTypeHash,
+				},
+				{
+					AttributeName: aws.String("CreationDate"),
+					KeyType:       types.KeyTypeRange,
+				},
+			},
+			Projection: &types.Projection{
+				ProjectionType: types.ProjectionTypeAll,
+			},
+			ProvisionedThroughput: &types.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(5),
+				WriteCapacityUnits: aws.Int64(5),
+			},
+		}
+	}
+
+	return []types.GlobalSecondaryIndex{
+		index(statusIndexName, "Status"),
+		index(creationDateIndexName, "EntityType"),
+	}
+}
+
 func createTable(client *dynamodb.Client) error {
 	_, err := client.CreateTable(context.TODO(), &dynamodb.CreateTableInput{
-		TableName: aws.String(tableName),
-		AttributeDefinitions: []types.AttributeDefinition{
-			{
-				AttributeName: aws.String("IncidentID"),
-				AttributeType: types.ScalarAttributeTypeS,
-			},
-		},
+		TableName:            aws.String(tableName),
+		AttributeDefinitions: attributeDefinitions(),
 		KeySchema: []types.KeySchemaElement{
 			{
 				AttributeName: aws.String("IncidentID"),
 				KeyType:       types.KeyTypeHash,
 			},
 		},
+		GlobalSecondaryIndexes: indexDefinitions(),
 		ProvisionedThroughput: &types.ProvisionedThroughput{
 			ReadCapacityUnits:  aws.Int64(5),
 			WriteCapacityUnits: aws.Int64(5),
@@ -59,20 +157,142 @@ func createTable(client *dynamodb.Client) error {
 	return nil
 }
 
-func createIncident(client *dynamodb.Client, incident Incident) error {
-	_, err := client.PutItem(context.TODO(), &dynamodb.PutItemInput{
-		TableName: aws.String(tableName),
-		Item: map[string]types.AttributeValue{
-			"IncidentID":   &types.AttributeValueMemberS{Value: incident.IncidentID},
-			"Title":        &types.AttributeValueMemberS{Value: incident.Title},
-			"Description":  &types.AttributeValueMemberS{Value: incident.Description},
-			"Status":       &types.AttributeValueMemberS{Value: incident.Status},
-			"CreationDate": &types.AttributeValueMemberS{Value: incident.CreationDate},
-		},
+// Adds the secondary indexes to a table created before they were introduced. DynamoDB builds one index
+// of a table at a time, so every index is waited for before the next one is created.
+func ensureIndexes(client *dynamodb.Client) error {
+	table, err := waitForTableActive(client)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, index := range table.GlobalSecondaryIndexes {
+		existing[aws.ToString(index.IndexName)] = true
+	}
+
+	for _, index := range indexDefinitions() {
+		if existing[aws.ToString(index.IndexName)] {
+			continue
+		}
+
+		_, err := client.UpdateTable(context.TODO(), &dynamodb.UpdateTableInput{
+			TableName:            aws.String(tableName),
+			AttributeDefinitions: attributeDefinitions(),
+			GlobalSecondaryIndexUpdates: []types.GlobalSecondaryIndexUpdate{
+				{
+					Create: &types.CreateGlobalSecondaryIndexAction{
+						IndexName:             index.IndexName,
+						KeySchema:             index.KeySchema,
+						Projection:            index.Projection,
+						ProvisionedThroughput: index.ProvisionedThroughput,
+					},
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Nie udało się utworzyć indeksu %s: %v", aws.ToString(index.IndexName), err)
+		}
+		if _, err := waitForTableActive(client); err != nil {
+			return err
+		}
+		log.Printf("Utworzono indeks %s w tabeli %s\n", aws.ToString(index.IndexName), tableName)
+	}
+
+	return nil
+}
+
+// waitForTableActive polls the table until it and all its indexes are ACTIVE, an index that is still
+// being built cannot be queried and blocks the creation of another one.
+func waitForTableActive(client *dynamodb.Client) (*types.TableDescription, error) {
+	deadline := time.Now().Add(tableActiveTimeout)
+	for {
+		output, err := client.DescribeTable(context.TODO(), &dynamodb.DescribeTableInput{
+			TableName: aws.String(tableName),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		active := output.Table.TableStatus == types.TableStatusActive
+		for _, index := range output.Table.GlobalSecondaryIndexes {
+			if index.IndexStatus != types.IndexStatusActive {
+				active = false
+			}
+		}
+		if active {
+			return output.Table, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Tabela %s i jej indeksy nie stały się aktywne w ciągu %v", tableName, tableActiveTimeout)
+		}
+		log.Printf("Oczekiwanie na aktywację tabeli %s i jej indeksów\n", tableName)
+		time.Sleep(tableActivePollInterval)
+	}
+}
+
+// Incident changes are written together with the outbox event describing them, so an event is never lost.
+func writeWithEvent(client *dynamodb.Client, event OutboxEvent, changes ...types.TransactWriteItem) error {
+	_, err := client.TransactWriteItems(context.TODO(), &dynamodb.TransactWriteItemsInput{
+		TransactItems: append(changes, types.TransactWriteItem{Put: outboxPut(event)}),
 	})
 	return err
 }
 
+// Returns the cancellation reason of the change at the given index if the transaction was cancelled because its condition failed.
+func failedCondition(err error, index int) (types.CancellationReason, bool) {
+	var cancelledErr *types.TransactionCanceledException
+	if !errors.As(err, &cancelledErr) || len(cancelledErr.CancellationReasons) <= index {
+		return types.CancellationReason{}, false
+	}
+	reason := cancelledErr.CancellationReasons[index]
+	return reason, aws.ToString(reason.Code) == "ConditionalCheckFailed"
+}
+
+func conditionFailed(err error, index int) bool {
+	_, failed := failedCondition(err, index)
+	return failed
+}
+
+// With an idempotency record the incident is only created if its key is not assigned to another incident yet,
+// and only if no active incident uses its short code.
+func createIncident(client *dynamodb.Client, incident Incident, event OutboxEvent, record *IdempotencyRecord, timeline []TimelineItem) error {
+	item, err := attributevalue.MarshalMap(incident)
+	if err != nil {
+		return fmt.Errorf("Nie udało się zserializować incydentu: %v", err)
+	}
+	item["EntityType"] = &types.AttributeValueMemberS{Value: incidentEntityType}
+
+	put := &types.Put{
+		TableName:           aws.String(tableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(IncidentID)"),
+	}
+
+	changes := []types.TransactWriteItem{{Put: put}}
+	if record != nil {
+		changes = append(changes, types.TransactWriteItem{Put: idempotencyPut(*record, time.Now())})
+	}
+	shortCodeIndex := len(changes)
+	changes = append(changes, types.TransactWriteItem{Put: shortCodeReservation(incident)})
+	puts, err := timelinePuts(timeline)
+	if err != nil {
+		return err
+	}
+	changes = append(changes, puts...)
+
+	err = writeWithEvent(client, event, changes...)
+	if record != nil && conditionFailed(err, 1) {
+		return errIdempotencyKeyTaken
+	}
+	if conditionFailed(err, 0) {
+		return errIncidentIDTaken
+	}
+	if conditionFailed(err, shortCodeIndex) {
+		return errShortCodeTaken
+	}
+	return err
+}
+
 func getIncident(client *dynamodb.Client, incidentID string) (*Incident, error) {
 	result, err := client.GetItem(context.TODO(), &dynamodb.GetItemInput{
 		TableName: aws.String(tableName),
@@ -85,43 +305,387 @@ func getIncident(client *dynamodb.Client, incidentID string) (*Incident, error)
 	}
 
 	if result.Item == nil {
-		return nil, fmt.Errorf("Nie znaleziono incydentu")
+		return nil, fmt.Errorf("%w o ID: %s", errIncidentNotFound, incidentID)
 	}
 
-	incident := Incident{
-		IncidentID:   incidentID,
-		Title:        result.Item["Title"].(*types.AttributeValueMemberS).Value,
-		Description:  result.Item["Description"].(*types.AttributeValueMemberS).Value,
-		Status:       result.Item["Status"].(*types.AttributeValueMemberS).Value,
-		CreationDate: result.Item["CreationDate"].(*types.AttributeValueMemberS).Value,
+	incident, err := incidentFromItem(result.Item)
+	if err != nil {
+		return nil, err
 	}
-
 	return &incident, nil
 }
 
-func updateIncident(client *dynamodb.Client, incidentID, newStatus string) error {
-	_, err := client.UpdateItem(context.TODO(), &dynamodb.UpdateItemInput{
+// Attributes are matched to the Incident fields by name; missing ones are left empty.
+func incidentFromItem(item map[string]types.AttributeValue) (Incident, error) {
+	var incident Incident
+	err := attributevalue.UnmarshalMap(item, &incident)
+	if err != nil {
+		return Incident{}, fmt.Errorf("%w: %v", errMalformedIncident, err)
+	}
+	if incident.IncidentID == "" {
+		return Incident{}, fmt.Errorf("%w: brak atrybutu IncidentID", errMalformedIncident)
+	}
+	return incident, nil
+}
+
+// BatchGetItem may leave some keys unprocessed under load; they are requested again a few times before giving up.
+const maxBatchGetAttempts = 5
+
+// getIncidents returns the incidents that exist among the given IDs, in no particular order.
+func getIncidents(client *dynamodb.Client, incidentIDs []string) ([]Incident, error) {
+	seen := make(map[string]bool, len(incidentIDs))
+	var keys []map[string]types.AttributeValue
+	for _, incidentID := range incidentIDs {
+		if seen[incidentID] {
+			continue
+		}
+		seen[incidentID] = true
+		keys = append(keys, map[string]types.AttributeValue{
+			"IncidentID": &types.AttributeValueMemberS{Value: incidentID},
+		})
+	}
+
+	var incidents []Incident
+	requestItems := map[string]types.KeysAndAttributes{
+		tableName: {Keys: keys},
+	}
+	for attempt := 1; len(requestItems) > 0; attempt++ {
+		if attempt > maxBatchGetAttempts {
+			return nil, fmt.Errorf("Nie udało się pobrać wszystkich incydentów po %d próbach", maxBatchGetAttempts)
+		}
+		if attempt > 1 {
+			time.Sleep(time.Duration(attempt*attempt) * 50 * time.Millisecond)
+		}
+
+		result, err := client.BatchGetItem(context.TODO(), &dynamodb.BatchGetItemInput{
+			RequestItems: requestItems,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Responses[tableName] {
+			incident, err := incidentFromItem(item)
+			if err != nil {
+				log.Printf("Pominięto incydent w niepoprawnym formacie: %v\n", err)
+				continue
+			}
+			incidents = append(incidents, incident)
+		}
+		requestItems = result.UnprocessedKeys
+	}
+
+	return incidents, nil
+}
+
+func indexForFilter(filter IncidentFilter) string {
+	if filter.Status != "" {
+		return statusIndexName
+	}
+	return creationDateIndexName
+}
+
+// Queries one of the secondary indexes, so listing never scans the whole table.
+// The returned key is the DynamoDB LastEvaluatedKey, nil when there are no more items.
+func listIncidents(client *dynamodb.Client, filter IncidentFilter, limit int32, startKey map[string]types.AttributeValue) ([]Incident, map[string]types.AttributeValue, error) {
+	indexName := indexForFilter(filter)
+	keyCondition := "#partition = :partition"
+	names := map[string]string{
+		"#partition": "EntityType",
+	}
+	values := map[string]types.AttributeValue{
+		":partition": &types.AttributeValueMemberS{Value: incidentEntityType},
+	}
+	if filter.Status != "" {
+		names["#partition"] = "Status"
+		values[":partition"] = &types.AttributeValueMemberS{Value: filter.Status}
+	}
+
+	switch {
+	case filter.CreatedFrom != "" && filter.CreatedTo != "":
+		keyCondition += " AND #creationDate BETWEEN :from AND :to"
+	case filter.CreatedFrom != "":
+		keyCondition += " AND #creationDate >= :from"
+	case filter.CreatedTo != "":
+		keyCondition += " AND #creationDate <= :to"
+	}
+	if filter.CreatedFrom != "" {
+		values[":from"] = &types.AttributeValueMemberS{Value: filter.CreatedFrom}
+	}
+	if filter.CreatedTo != "" {
+		values[":to"] = &types.AttributeValueMemberS{Value: filter.CreatedTo}
+	}
+	if filter.CreatedFrom != "" || filter.CreatedTo != "" {
+		names["#creationDate"] = "CreationDate"
+	}
+
+	result, err := client.Query(context.TODO(), &dynamodb.QueryInput{
+		TableName:                 aws.String(tableName),
+		IndexName:                 aws.String(indexName),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int32(limit),
+		ExclusiveStartKey:         startKey,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	incidents := make([]Incident, 0, len(result.Items))
+	for _, item := range result.Items {
+		incident, err := incidentFromItem(item)
+		if err != nil {
+			log.Printf("Pominięto incydent w liście: %v\n", err)
+			continue
+		}
+		incidents = append(incidents, incident)
+	}
+
+	return incidents, result.LastEvaluatedKey, nil
+}
+
+// IncidentUpdate holds the changes made by one UpdateIncident call, nil parts are left unchanged.
+type IncidentUpdate struct {
+	Status      *StatusTransition
+	Assignments *IncidentAssignments
+	// ShortCodeRelease frees the short code of an incident leaving the active statuses.
+	ShortCodeRelease *types.Delete
+}
+
+type IncidentAssignments struct {
+	LifeguardIDs []int64
+	VehicleIDs   []int64
+}
+
+// The update only succeeds if the stored status is still the one the changes were validated against.
+func updateIncident(client *dynamodb.Client, incidentID, currentStatus string, change IncidentUpdate, event OutboxEvent, timeline []TimelineItem) error {
+	var set, remove []string
+	names := map[string]string{
+		"#status": "Status",
+	}
+	values := map[string]types.AttributeValue{
+		":current": &types.AttributeValueMemberS{Value: currentStatus},
+	}
+
+	if transition := change.Status; transition != nil {
+		set = append(set, "#status = :to", "#statusChangedAt = :changedAt")
+		names["#statusChangedAt"] = "StatusChangedAt"
+		values[":to"] = &types.AttributeValueMemberS{Value: transition.To}
+		values[":changedAt"] = &types.AttributeValueMemberS{Value: transition.ChangedAt}
+
+		to, _ := parseIncidentStatus(transition.To)
+		if attribute, ok := statusTimestampAttributes[to]; ok {
+			set = append(set, "#statusTimestamp = :changedAt")
+			names["#statusTimestamp"] = attribute
+		}
+		if transition.ResolutionNote != "" {
+			set = append(set, "#resolutionNote = :resolutionNote")
+			names["#resolutionNote"] = "ResolutionNote"
+			values[":resolutionNote"] = &types.AttributeValueMemberS{Value: transition.ResolutionNote}
+		}
+		if transition.CancellationReason != "" {
+			set = append(set, "#cancellationReason = :cancellationReason")
+			names["#cancellationReason"] = "CancellationReason"
+			values[":cancellationReason"] = &types.AttributeValueMemberS{Value: transition.CancellationReason}
+		}
+	}
+
+	if assignments := change.Assignments; assignments != nil {
+		for attribute, ids := range map[string][]int64{
+			"AssignedLifeguardIDs": assignments.LifeguardIDs,
+			"AssignedVehicleIDs":   assignments.VehicleIDs,
+		} {
+			names["#"+attribute] = attribute
+			// Empty lists are not stored, as with omitempty when the incident is created.
+			if len(ids) == 0 {
+				remove = append(remove, "#"+attribute)
+				continue
+			}
+			value, err := attributevalue.Marshal(ids)
+			if err != nil {
+				return fmt.Errorf("Nie udało się zserializować przypisań incydentu: %v", err)
+			}
+			set = append(set, "#"+attribute+" = :"+attribute)
+			values[":"+attribute] = value
+		}
+	}
+
+	updateExpression := ""
+	if len(set) > 0 {
+		updateExpression = "SET " + strings.Join(set, ", ")
+	}
+	if len(remove) > 0 {
+		updateExpression = strings.TrimSpace(updateExpression + " REMOVE " + strings.Join(remove, ", "))
+	}
+
+	update := &types.Update{
 		TableName: aws.String(tableName),
 		Key: map[string]types.AttributeValue{
 			"IncidentID": &types.AttributeValueMemberS{Value: incidentID},
 		},
-		UpdateExpression: aws.String("SET #status = :newStatus"),
-		ExpressionAttributeNames: map[string]string{
-			"#status": "Status",
-		},
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":newStatus": &types.AttributeValueMemberS{Value: newStatus},
-		},
-	})
+		UpdateExpression:                    aws.String(updateExpression),
+		ConditionExpression:                 aws.String("attribute_exists(IncidentID) AND #status = :current"),
+		ExpressionAttributeNames:            names,
+		ExpressionAttributeValues:           values,
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	}
+
+	puts, err := timelinePuts(timeline)
+	if err != nil {
+		return err
+	}
+
+	changes := []types.TransactWriteItem{{Update: update}}
+	if change.ShortCodeRelease != nil {
+		changes = append(changes, types.TransactWriteItem{Delete: change.ShortCodeRelease})
+	}
+	err = writeWithEvent(client, event, append(changes, puts...)...)
+	if reason, failed := failedCondition(err, 0); failed {
+		if len(reason.Item) == 0 {
+			return fmt.Errorf("%w o ID: %s", errIncidentNotFound, incidentID)
+		}
+		return errStatusChanged
+	}
 	return err
 }
 
-func deleteIncident(client *dynamodb.Client, incidentID string) error {
-	_, err := client.DeleteItem(context.TODO(), &dynamodb.DeleteItemInput{
+// The timeline is kept after deletion, ending with a system entry.
+func deleteIncident(client *dynamodb.Client, incident Incident, event OutboxEvent, entry TimelineItem) error {
+	incidentID := incident.IncidentID
+	del := &types.Delete{
 		TableName: aws.String(tableName),
 		Key: map[string]types.AttributeValue{
 			"IncidentID": &types.AttributeValueMemberS{Value: incidentID},
 		},
-	})
+		ConditionExpression: aws.String("attribute_exists(IncidentID)"),
+	}
+
+	put, err := timelinePut(entry)
+	if err != nil {
+		return err
+	}
+
+	changes := []types.TransactWriteItem{{Delete: del}, put}
+	if release := shortCodeRelease(incident); release != nil {
+		changes = append(changes, types.TransactWriteItem{Delete: release})
+	}
+	err = writeWithEvent(client, event, changes...)
+	if conditionFailed(err, 0) {
+		return fmt.Errorf("%w o ID: %s", errIncidentNotFound, incidentID)
+	}
 	return err
 }
+
+// normalizeIncidentStatuses scans the whole table on startup. Each update is conditional on the status read by
+// the scan, so an incident changed by a request in the meantime is skipped instead of being overwritten with
+// a stale value; unknown statuses are logged and left for manual review.
+func normalizeIncidentStatuses(client *dynamodb.Client) error {
+	paginator := dynamodb.NewScanPaginator(client, &dynamodb.ScanInput{
+		TableName:            aws.String(tableName),
+		ProjectionExpression: aws.String("IncidentID, #status"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "Status",
+		},
+	})
+
+	normalizedCount := 0
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Items {
+			incidentID, ok := item["IncidentID"].(*types.AttributeValueMemberS)
+			if !ok {
+				continue
+			}
+			current, ok := item["Status"].(*types.AttributeValueMemberS)
+			if !ok || current.Value == "" {
+				continue
+			}
+
+			status, ok := parseIncidentStatus(current.Value)
+			if !ok {
+				log.Printf("Nie udało się znormalizować statusu %q incydentu o ID: %s\n", current.Value, incidentID.Value)
+				continue
+			}
+			normalized := incidentStatusName(status)
+			if normalized == current.Value {
+				continue
+			}
+
+			_, err := client.UpdateItem(context.TODO(), &dynamodb.UpdateItemInput{
+				TableName: aws.String(tableName),
+				Key: map[string]types.AttributeValue{
+					"IncidentID": incidentID,
+				},
+				UpdateExpression:    aws.String("SET #status = :normalized"),
+				ConditionExpression: aws.String("#status = :current"),
+				ExpressionAttributeNames: map[string]string{
+					"#status": "Status",
+				},
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":normalized": &types.AttributeValueMemberS{Value: normalized},
+					":current":    current,
+				},
+			})
+			if err != nil {
+				var conditionErr *types.ConditionalCheckFailedException
+				if errors.As(err, &conditionErr) {
+					continue
+				}
+				return err
+			}
+			normalizedCount++
+		}
+	}
+
+	log.Printf("Znormalizowano statusy %d incydentów\n", normalizedCount)
+	return nil
+}
+
+// Incidents stored before the secondary indexes existed have no EntityType and would be missing from listings.
+func backfillEntityType(client *dynamodb.Client) error {
+	paginator := dynamodb.NewScanPaginator(client, &dynamodb.ScanInput{
+		TableName:            aws.String(tableName),
+		ProjectionExpression: aws.String("IncidentID"),
+		FilterExpression:     aws.String("attribute_not_exists(EntityType)"),
+	})
+
+	updatedCount := 0
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Items {
+			_, err := client.UpdateItem(context.TODO(), &dynamodb.UpdateItemInput{
+				TableName: aws.String(tableName),
+				Key: map[string]types.AttributeValue{
+					"IncidentID": item["IncidentID"],
+				},
+				UpdateExpression: aws.String("SET EntityType = :entityType"),
+				// An incident deleted since the scan must not come back as an item holding only the key.
+				ConditionExpression: aws.String("attribute_exists(IncidentID)"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":entityType": &types.AttributeValueMemberS{Value: incidentEntityType},
+				},
+			})
+			if err != nil {
+				var conditionErr *types.ConditionalCheckFailedException
+				if errors.As(err, &conditionErr) {
+					continue
+				}
+				return err
+			}
+			updatedCount++
+		}
+	}
+
+	log.Printf("Uzupełniono atrybut EntityType w %d incydentach\n", updatedCount)
+	return nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/protobuf/proto"
+)
+
+const idempotencyKeyTTL = 24 * time.Hour
+
+var errIdempotencyKeyReused = errors.New("Klucz idempotencji został już użyty dla innego żądania")
+
+// IdempotencyRecord binds a key chosen by a caller to the incident created with it. The same key sent by
+// another caller is a different record, so clients never receive each other's incidents.
+type IdempotencyRecord struct {
+	IdempotencyKey string
+	Caller         string
+	IncidentID     string
+	RequestHash    string
+}
+
+// The stored key combines the caller and its key. The caller is also stored on its own and compared on
+// every hit, so a subject containing the separator cannot reach the record of another caller.
+// Records written before keys were scoped hold the bare key and are left to expire.
+func (r IdempotencyRecord) storageKey() string {
+	return r.Caller + "#" + r.IdempotencyKey
+}
+
+// The version of createRequestHash is stored in front of the hash, so that a later change of the scheme
+// can tell the records written before it.
+const requestHashVersion = "v2"
+
+// Identifies the request payload, so that a key reused for a different incident is rejected instead of returning the wrong one.
+func createRequestHash(req *CreateIncidentRequest) string {
+	payload := proto.Clone(req).(*CreateIncidentRequest)
+	payload.IdempotencyKey = ""
+
+	encoded, _ := proto.MarshalOptions{Deterministic: true}.Marshal(payload)
+	hash := sha256.Sum256(encoded)
+	return requestHashVersion + ":" + hex.EncodeToString(hash[:])
+}
+
+// A key whose TTL has passed may be reused even if DynamoDB has not removed the item yet.
+func idempotencyPut(record IdempotencyRecord, now time.Time) *types.Put {
+	return &types.Put{
+		TableName: aws.String(idempotencyTableName),
+		Item: map[string]types.AttributeValue{
+			"IdempotencyKey": &types.AttributeValueMemberS{Value: record.storageKey()},
+			"Caller":         &types.AttributeValueMemberS{Value: record.Caller},
+			"IncidentID":     &types.AttributeValueMemberS{Value: record.IncidentID},
+			"RequestHash":    &types.AttributeValueMemberS{Value: record.RequestHash},
+			"ExpiresAt":      &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Add(idempotencyKeyTTL).Unix(), 10)},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(IdempotencyKey) OR ExpiresAt < :now"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":now": &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)},
+		},
+	}
+}
+
+func getIdempotencyRecord(client *dynamodb.Client, record IdempotencyRecord) (*IdempotencyRecord, error) {
+	result, err := client.GetItem(context.TODO(), &dynamodb.GetItemInput{
+		TableName: aws.String(idempotencyTableName),
+		Key: map[string]types.AttributeValue{
+			"IdempotencyKey": &types.AttributeValueMemberS{Value: record.storageKey()},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, fmt.Errorf("Nie znaleziono klucza idempotencji %q", record.IdempotencyKey)
+	}
+
+	return &IdempotencyRecord{
+		IdempotencyKey: record.IdempotencyKey,
+		Caller:         optionalString(result.Item, "Caller"),
+		IncidentID:     optionalString(result.Item, "IncidentID"),
+		RequestHash:    optionalString(result.Item, "RequestHash"),
+	}, nil
+}
+
+func ensureIdempotencyTable(client *dynamodb.Client) error {
+	_, err := client.DescribeTable(context.TODO(), &dynamodb.DescribeTableInput{
+		TableName: aws.String(idempotencyTableName),
+	})
+	if err == nil {
+		return nil
+	}
+
+	var nfe *types.ResourceNotFoundException
+	if !errors.As(err, &nfe) {
+		return err
+	}
+
+	_, err = client.CreateTable(context.TODO(), &dynamodb.CreateTableInput{
+		TableName: aws.String(idempotencyTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("IdempotencyKey"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("IdempotencyKey"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		ProvisionedThroughput: &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(5),
+			WriteCapacityUnits: aws.Int64(5),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.UpdateTimeToLive(context.TODO(), &dynamodb.UpdateTimeToLiveInput{
+		TableName: aws.String(idempotencyTableName),
+		TimeToLiveSpecification: &types.TimeToLiveSpecification{
+			AttributeName: aws.String("ExpiresAt"),
+			Enabled:       aws.Bool(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Nie udało się włączyć TTL w tabeli %s: %v", idempotencyTableName, err)
+	}
+
+	log.Printf("Utworzono tabelę %s\n", idempotencyTableName)
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import "strings"
+
+const (
+	incidentSeverityPrefix = "INCIDENT_SEVERITY_"
+	incidentCategoryPrefix = "INCIDENT_CATEGORY_"
+)
+
+var defaultPriorities = map[IncidentSeverity]int32{
+	IncidentSeverity_INCIDENT_SEVERITY_CRITICAL: 1,
+	IncidentSeverity_INCIDENT_SEVERITY_HIGH:     2,
+	IncidentSeverity_INCIDENT_SEVERITY_MODERATE: 3,
+	IncidentSeverity_INCIDENT_SEVERITY_LOW:      4,
+}
+
+// Severity and category are stored without the enum prefix, empty when unspecified.
+func severityName(severity IncidentSeverity) string {
+	if severity == IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(severity.String(), incidentSeverityPrefix)
+}
+
+func parseSeverity(name string) IncidentSeverity {
+	return IncidentSeverity(IncidentSeverity_value[incidentSeverityPrefix+name])
+}
+
+func categoryName(category IncidentCategory) string {
+	if category == IncidentCategory_INCIDENT_CATEGORY_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(category.String(), incidentCategoryPrefix)
+}
+
+func parseCategory(name string) IncidentCategory {
+	return IncidentCategory(IncidentCategory_value[incidentCategoryPrefix+name])
+}
+
+func incidentPriority(priority int32, severity IncidentSeverity) int32 {
+	if priority != 0 {
+		return priority
+	}
+	return defaultPriorities[severity]
+}
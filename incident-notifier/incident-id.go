@@ -0,0 +1,107 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	incidentIDPrefix = "INC-"
+	shortCodeLength  = 4
+	// Crockford's base32 leaves out I, L, O and U, so codes read over the radio are not confused.
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+type IncidentIdentifier struct {
+	ID        string
+	ShortCode string
+}
+
+// IncidentIDGenerator creates identifiers that sort by creation time and are unique across replicas.
+type IncidentIDGenerator interface {
+	NewIncidentID(now time.Time) (IncidentIdentifier, error)
+}
+
+type ulidGenerator struct{}
+
+type uuidV7Generator struct{}
+
+// The generator is chosen with the INCIDENT_ID_FORMAT environment variable, ULID by default.
+func newIncidentIDGenerator() (IncidentIDGenerator, error) {
+	switch format := strings.ToLower(os.Getenv("INCIDENT_ID_FORMAT")); format {
+	case "", "ulid":
+		return ulidGenerator{}, nil
+	case "uuidv7":
+		return uuidV7Generator{}, nil
+	default:
+		return nil, fmt.Errorf("Nieznany format identyfikatora incydentu: %q", format)
+	}
+}
+
+// 48 bits of milliseconds since the epoch followed by 80 random bits.
+func timestampedRandom(now time.Time) ([16]byte, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[6:]); err != nil {
+		return b, fmt.Errorf("Nie udało się wygenerować identyfikatora incydentu: %w", err)
+	}
+
+	var millis [8]byte
+	binary.BigEndian.PutUint64(millis[:], uint64(now.UnixMilli()))
+	copy(b[:6], millis[2:])
+	return b, nil
+}
+
+func encodeCrockford(b [16]byte) string {
+	high := binary.BigEndian.Uint64(b[:8])
+	low := binary.BigEndian.Uint64(b[8:])
+
+	// 26 characters hold 130 bits; the two leading bits are always zero.
+	var encoded [26]byte
+	for i := len(encoded) - 1; i >= 0; i-- {
+		encoded[i] = crockfordAlphabet[low&0x1f]
+		low = low>>5 | high<<59
+		high >>= 5
+	}
+	return string(encoded[:])
+}
+
+// The short code comes from the random bits only, so incidents created in the same millisecond still get different codes.
+func shortCode(b [16]byte) string {
+	random := binary.BigEndian.Uint64(b[8:])
+	code := make([]byte, shortCodeLength)
+	for i := range code {
+		code[i] = crockfordAlphabet[random&0x1f]
+		random >>= 5
+	}
+	return incidentIDPrefix + string(code)
+}
+
+func (ulidGenerator) NewIncidentID(now time.Time) (IncidentIdentifier, error) {
+	b, err := timestampedRandom(now)
+	if err != nil {
+		return IncidentIdentifier{}, err
+	}
+
+	return IncidentIdentifier{
+		ID:        incidentIDPrefix + encodeCrockford(b),
+		ShortCode: shortCode(b),
+	}, nil
+}
+
+func (uuidV7Generator) NewIncidentID(now time.Time) (IncidentIdentifier, error) {
+	b, err := timestampedRandom(now)
+	if err != nil {
+		return IncidentIdentifier{}, err
+	}
+	b[6] = (b[6] & 0x0f) | 0x70
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return IncidentIdentifier{
+		ID:        incidentIDPrefix + fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]),
+		ShortCode: shortCode(b),
+	}, nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/szbobrowski/master-thesis/events"
+	"github.com/szbobrowski/master-thesis/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var allowedTransitions = map[IncidentStatus][]IncidentStatus{
+	IncidentStatus_INCIDENT_STATUS_REPORTED: {
+		IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED,
+		IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+		IncidentStatus_INCIDENT_STATUS_CANCELLED,
+	},
+	IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED: {
+		IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+		IncidentStatus_INCIDENT_STATUS_CANCELLED,
+	},
+	IncidentStatus_INCIDENT_STATUS_DISPATCHED: {
+		IncidentStatus_INCIDENT_STATUS_ON_SCENE,
+		IncidentStatus_INCIDENT_STATUS_CANCELLED,
+	},
+	IncidentStatus_INCIDENT_STATUS_ON_SCENE: {
+		IncidentStatus_INCIDENT_STATUS_RESOLVED,
+	},
+	IncidentStatus_INCIDENT_STATUS_RESOLVED: {
+		IncidentStatus_INCIDENT_STATUS_CLOSED,
+	},
+}
+
+var statusTimestampAttributes = map[IncidentStatus]string{
+	IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED: "AcknowledgedAt",
+	IncidentStatus_INCIDENT_STATUS_DISPATCHED:   "DispatchedAt",
+	IncidentStatus_INCIDENT_STATUS_ON_SCENE:     "OnSceneAt",
+	IncidentStatus_INCIDENT_STATUS_RESOLVED:     "ResolvedAt",
+	IncidentStatus_INCIDENT_STATUS_CLOSED:       "ClosedAt",
+	IncidentStatus_INCIDENT_STATUS_CANCELLED:    "CancelledAt",
+}
+
+type StatusTransition struct {
+	From               string
+	To                 string
+	ChangedAt          string
+	ResolutionNote     string
+	CancellationReason string
+}
+
+// An incident whose stored status could not be normalized may move to any status,
+// so that legacy records can still be brought back into the lifecycle.
+func canTransition(from, to IncidentStatus) bool {
+	if from == IncidentStatus_INCIDENT_STATUS_UNSPECIFIED {
+		return to != IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+	}
+	for _, allowed := range allowedTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+func validateTransition(from, to IncidentStatus, req *UpdateIncidentRequest) error {
+	if !canTransition(from, to) {
+		return status.Errorf(codes.FailedPrecondition, "Niedozwolona zmiana statusu incydentu z %s na %s", incidentStatusName(from), incidentStatusName(to))
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	if to == IncidentStatus_INCIDENT_STATUS_RESOLVED && strings.TrimSpace(req.ResolutionNote) == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "resolution_note",
+			Description: "Notatka z rozwiązania jest wymagana przy zmianie statusu na RESOLVED",
+		})
+	}
+	if to == IncidentStatus_INCIDENT_STATUS_CANCELLED && strings.TrimSpace(req.CancellationReason) == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "cancellation_reason",
+			Description: "Powód anulowania jest wymagany przy zmianie statusu na CANCELLED",
+		})
+	}
+	if len(violations) > 0 {
+		return validation.InvalidArgumentError(violations)
+	}
+
+	return nil
+}
+
+func applyTransition(incident Incident, transition StatusTransition) Incident {
+	incident.Status = transition.To
+	incident.StatusChangedAt = transition.ChangedAt
+
+	to, _ := parseIncidentStatus(transition.To)
+	switch to {
+	case IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED:
+		incident.AcknowledgedAt = transition.ChangedAt
+	case IncidentStatus_INCIDENT_STATUS_DISPATCHED:
+		incident.DispatchedAt = transition.ChangedAt
+	case IncidentStatus_INCIDENT_STATUS_ON_SCENE:
+		incident.OnSceneAt = transition.ChangedAt
+	case IncidentStatus_INCIDENT_STATUS_RESOLVED:
+		incident.ResolvedAt = transition.ChangedAt
+	case IncidentStatus_INCIDENT_STATUS_CLOSED:
+		incident.ClosedAt = transition.ChangedAt
+	case IncidentStatus_INCIDENT_STATUS_CANCELLED:
+		incident.CancelledAt = transition.ChangedAt
+	}
+
+	if transition.ResolutionNote != "" {
+		incident.ResolutionNote = transition.ResolutionNote
+	}
+	if transition.CancellationReason != "" {
+		incident.CancellationReason = transition.CancellationReason
+	}
+	return incident
+}
+
+var statusEventTypes = map[IncidentStatus]events.EventType{
+	IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED: events.IncidentAcknowledged,
+	IncidentStatus_INCIDENT_STATUS_DISPATCHED:   events.IncidentDispatched,
+	IncidentStatus_INCIDENT_STATUS_ON_SCENE:     events.IncidentOnScene,
+	IncidentStatus_INCIDENT_STATUS_RESOLVED:     events.IncidentResolved,
+	IncidentStatus_INCIDENT_STATUS_CLOSED:       events.IncidentClosed,
+	IncidentStatus_INCIDENT_STATUS_CANCELLED:    events.IncidentCancelled,
+}
+
+func statusEventType(to IncidentStatus) events.EventType {
+	if eventType, ok := statusEventTypes[to]; ok {
+		return eventType
+	}
+	return events.IncidentUpdated
+}
@@ -2,109 +2,521 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/szbobrowski/master-thesis/auth"
+	"github.com/szbobrowski/master-thesis/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type IncidentServer struct {
 	UnimplementedIncidentServiceServer
-	dbClient   *dynamodb.Client
-	sqsManager *SQSManager
+	dbClient    *dynamodb.Client
+	relay       *OutboxRelay
+	idGenerator IncidentIDGenerator
+	broadcaster *IncidentBroadcaster
 }
 
-func NewIncidentServer(client *dynamodb.Client, queue *SQSManager) *IncidentServer {
-	return &IncidentServer{dbClient: client, sqsManager: queue}
+const maxIncidentIDAttempts = 3
+
+func NewIncidentServer(client *dynamodb.Client, relay *OutboxRelay, idGenerator IncidentIDGenerator, broadcaster *IncidentBroadcaster) *IncidentServer {
+	return &IncidentServer{dbClient: client, relay: relay, idGenerator: idGenerator, broadcaster: broadcaster}
+}
+
+func toIncidentProto(incident Incident) *IncidentProto {
+	incidentStatus, _ := parseIncidentStatus(incident.Status)
+
+	return &IncidentProto{
+		IncidentID:           incident.IncidentID,
+		ShortCode:            incident.ShortCode,
+		Title:                incident.Title,
+		Description:          incident.Description,
+		LegacyStatus:         incident.Status,
+		CreationDate:         incident.CreationDate,
+		Status:               incidentStatus,
+		StatusChangedAt:      incident.StatusChangedAt,
+		AcknowledgedAt:       incident.AcknowledgedAt,
+		DispatchedAt:         incident.DispatchedAt,
+		OnSceneAt:            incident.OnSceneAt,
+		ResolvedAt:           incident.ResolvedAt,
+		ClosedAt:             incident.ClosedAt,
+		CancelledAt:          incident.CancelledAt,
+		ResolutionNote:       incident.ResolutionNote,
+		CancellationReason:   incident.CancellationReason,
+		Severity:             parseSeverity(incident.Severity),
+		Priority:             incident.Priority,
+		Category:             parseCategory(incident.Category),
+		Location:             toLocationProto(incident),
+		Reporter:             toReporterProto(incident),
+		PeopleInvolved:       incident.PeopleInvolved,
+		AssignedLifeguardIds: incident.AssignedLifeguardIDs,
+		AssignedVehicleIds:   incident.AssignedVehicleIDs,
+	}
+}
+
+func toLocationProto(incident Incident) *Location {
+	if incident.Latitude == nil && incident.Longitude == nil && incident.Zone == "" {
+		return nil
+	}
+
+	location := &Location{Zone: incident.Zone}
+	if incident.Latitude != nil && incident.Longitude != nil {
+		location.Latitude = *incident.Latitude
+		location.Longitude = *incident.Longitude
+	}
+	return location
+}
+
+func toReporterProto(incident Incident) *ReporterContact {
+	if incident.ReporterName == "" && incident.ReporterPhone == "" && incident.ReporterEmail == "" {
+		return nil
+	}
+	return &ReporterContact{
+		Name:  incident.ReporterName,
+		Phone: incident.ReporterPhone,
+		Email: incident.ReporterEmail,
+	}
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, errIncidentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errStatusChanged):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, errMalformedIncident):
+		return status.Error(codes.Internal, err.Error())
+	}
+	return err
+}
+
+// Creation dates are stored in UTC, so that they sort chronologically in the secondary indexes.
+func utcTimestamp(value string) string {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return parsed.UTC().Format(time.RFC3339)
 }
 
 func (s *IncidentServer) CreateIncident(ctx context.Context, req *CreateIncidentRequest) (*IncidentResponse, error) {
-	incidentID := fmt.Sprintf("INC%d", time.Now().UnixNano())
-	incident := Incident{
-		IncidentID:   incidentID,
-		Title:        req.Title,
-		Description:  req.Description,
-		Status:       req.Status,
-		CreationDate: req.CreationDate,
+	initialStatus := incidentStatusName(IncidentStatus_INCIDENT_STATUS_REPORTED)
+	if req.Status != IncidentStatus_INCIDENT_STATUS_UNSPECIFIED || req.LegacyStatus != "" {
+		requestedStatus, err := resolveIncidentStatus(req.Status, req.LegacyStatus)
+		if err != nil {
+			return nil, err
+		}
+		if requestedStatus != initialStatus {
+			return nil, fieldViolationError("status", fmt.Sprintf("Nowy incydent musi mieć status %s", initialStatus))
+		}
+	}
+
+	var incident Incident
+	var record *IdempotencyRecord
+	var err error
+	for attempt := 1; ; attempt++ {
+		incident, record, err = s.storeNewIncident(ctx, req, initialStatus)
+		if !errors.Is(err, errIncidentIDTaken) && !errors.Is(err, errShortCodeTaken) || attempt == maxIncidentIDAttempts {
+			break
+		}
+		log.Printf("Identyfikator incydentu %s lub kod %s jest już zajęty, generowanie nowego\n", incident.IncidentID, incident.ShortCode)
 	}
 
-	err := createIncident(s.dbClient, incident)
+	if errors.Is(err, errIdempotencyKeyTaken) {
+		return s.originalIncident(record, req)
+	}
+	if errors.Is(err, errIncidentIDTaken) || errors.Is(err, errShortCodeTaken) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
 	if err != nil {
 		log.Printf("Nie udało się utworzyć incydentu: %v\n", err)
 		return nil, err
 	}
-	log.Printf("Incydent został utworzony: %s\n", incidentID)
+	log.Printf("Incydent został utworzony: %s (%s)\n", incident.IncidentID, incident.ShortCode)
 
-	s.sqsManager.SendMessage(incident, "CREATE")
+	s.relay.Notify()
+	s.broadcaster.Publish(IncidentChangeType_INCIDENT_CHANGE_TYPE_CREATED, incident)
 
-	return &IncidentResponse{Incident: &IncidentProto{
-		IncidentID:   incident.IncidentID,
-		Title:        incident.Title,
-		Description:  incident.Description,
-		Status:       incident.Status,
-		CreationDate: incident.CreationDate,
-	}}, nil
+	return &IncidentResponse{Incident: toIncidentProto(incident)}, nil
+}
+
+func (s *IncidentServer) storeNewIncident(ctx context.Context, req *CreateIncidentRequest, initialStatus string) (Incident, *IdempotencyRecord, error) {
+	now := time.Now().UTC()
+	identifier, err := s.idGenerator.NewIncidentID(now)
+	if err != nil {
+		return Incident{}, nil, err
+	}
+
+	incident := Incident{
+		IncidentID:           identifier.ID,
+		ShortCode:            identifier.ShortCode,
+		ShortCodeReserved:    true,
+		Title:                req.Title,
+		Description:          req.Description,
+		Status:               initialStatus,
+		CreationDate:         utcTimestamp(req.CreationDate),
+		StatusChangedAt:      now.Format(time.RFC3339),
+		Severity:             severityName(req.Severity),
+		Priority:             incidentPriority(req.Priority, req.Severity),
+		Category:             categoryName(req.Category),
+		PeopleInvolved:       req.PeopleInvolved,
+		AssignedLifeguardIDs: req.AssignedLifeguardIds,
+		AssignedVehicleIDs:   req.AssignedVehicleIds,
+	}
+	if req.Location != nil {
+		latitude, longitude := req.Location.Latitude, req.Location.Longitude
+		incident.Latitude = &latitude
+		incident.Longitude = &longitude
+		incident.Zone = req.Location.Zone
+	}
+	if req.Reporter != nil {
+		incident.ReporterName = req.Reporter.Name
+		incident.ReporterPhone = req.Reporter.Phone
+		incident.ReporterEmail = req.Reporter.Email
+	}
+
+	event, err := newOutboxEvent(incident, events.IncidentCreated)
+	if err != nil {
+		return incident, nil, err
+	}
+
+	var record *IdempotencyRecord
+	if req.IdempotencyKey != "" {
+		claims, _ := auth.FromContext(ctx)
+		record = &IdempotencyRecord{
+			IdempotencyKey: req.IdempotencyKey,
+			Caller:         claims.Subject,
+			IncidentID:     incident.IncidentID,
+			RequestHash:    createRequestHash(req),
+		}
+	}
+
+	timeline, err := creationTimeline(incident, now)
+	if err != nil {
+		return incident, nil, err
+	}
+
+	return incident, record, createIncident(s.dbClient, incident, event, record, timeline)
+}
+
+// Returns the incident created by the first request with the given idempotency key.
+func (s *IncidentServer) originalIncident(record *IdempotencyRecord, req *CreateIncidentRequest) (*IncidentResponse, error) {
+	original, err := getIdempotencyRecord(s.dbClient, *record)
+	if err != nil {
+		log.Printf("Nie udało się pobrać klucza idempotencji %q, błąd: %v\n", record.IdempotencyKey, err)
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	if original.Caller != record.Caller || original.RequestHash != createRequestHash(req) {
+		return nil, fieldViolationError("idempotency_key", errIdempotencyKeyReused.Error())
+	}
+
+	incident, err := getIncident(s.dbClient, original.IncidentID)
+	if err != nil {
+		log.Printf("Nie udało się pobrać incydentu o ID: %s, błąd: %v\n", original.IncidentID, err)
+		return nil, storageError(err)
+	}
+	log.Printf("Zwrócono incydent %s utworzony wcześniej z kluczem idempotencji %q\n", incident.IncidentID, record.IdempotencyKey)
+
+	return &IncidentResponse{Incident: toIncidentProto(*incident)}, nil
 }
 
 func (s *IncidentServer) GetIncident(ctx context.Context, req *GetIncidentRequest) (*IncidentResponse, error) {
 	incident, err := getIncident(s.dbClient, req.IncidentID)
 	if err != nil {
 		log.Printf("Nie udało się pobrać incydentu o ID: %s, błąd: %v\n", req.IncidentID, err)
-		return nil, err
+		return nil, storageError(err)
 	}
 
 	log.Printf("Pobrano incydent: %+v\n", incident)
 
-	return &IncidentResponse{Incident: &IncidentProto{
-		IncidentID:   incident.IncidentID,
-		Title:        incident.Title,
-		Description:  incident.Description,
-		Status:       incident.Status,
-		CreationDate: incident.CreationDate,
-	}}, nil
+	return &IncidentResponse{Incident: toIncidentProto(*incident)}, nil
 }
 
 func (s *IncidentServer) UpdateIncident(ctx context.Context, req *UpdateIncidentRequest) (*IncidentResponse, error) {
-	err := updateIncident(s.dbClient, req.IncidentID, req.Status)
-	if err != nil {
-		log.Printf("Nie udało się zaktualizować incydentu o ID: %s, błąd: %v\n", req.IncidentID, err)
-		return nil, err
+	assignmentsOnly := req.Status == IncidentStatus_INCIDENT_STATUS_UNSPECIFIED && req.LegacyStatus == "" &&
+		(req.AssignedLifeguards != nil || req.AssignedVehicles != nil)
+
+	var newStatus string
+	if !assignmentsOnly {
+		var err error
+		newStatus, err = resolveIncidentStatus(req.Status, req.LegacyStatus)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	updatedIncident, err := getIncident(s.dbClient, req.IncidentID)
+	incident, err := getIncident(s.dbClient, req.IncidentID)
 	if err != nil {
 		log.Printf("Nie udało się pobrać incydentu o ID: %s, błąd: %v\n", req.IncidentID, err)
+		return nil, storageError(err)
+	}
+
+	now := time.Now().UTC()
+	updatedIncident := *incident
+	var change IncidentUpdate
+	var timeline []TimelineItem
+	eventType := events.IncidentUpdated
+
+	if !assignmentsOnly {
+		from, _ := parseIncidentStatus(incident.Status)
+		to, _ := parseIncidentStatus(newStatus)
+		err = validateTransition(from, to, req)
+		if err != nil {
+			log.Printf("Odrzucono zmianę statusu incydentu o ID: %s, błąd: %v\n", req.IncidentID, err)
+			return nil, err
+		}
+
+		change.Status = &StatusTransition{
+			From:               incident.Status,
+			To:                 newStatus,
+			ChangedAt:          now.Format(time.RFC3339),
+			ResolutionNote:     req.ResolutionNote,
+			CancellationReason: req.CancellationReason,
+		}
+		updatedIncident = applyTransition(updatedIncident, *change.Status)
+		eventType = statusEventType(to)
+		if releasesShortCode(to) {
+			change.ShortCodeRelease = shortCodeRelease(*incident)
+		}
+
+		entry, err := statusChangeTimelineItem(req.IncidentID, *change.Status, now)
+		if err != nil {
+			return nil, err
+		}
+		timeline = append(timeline, entry)
+	}
+
+	if assignments, changed := applyAssignments(&updatedIncident, req); changed {
+		change.Assignments = assignments
+
+		entry, err := assignmentTimelineItem(updatedIncident, now)
+		if err != nil {
+			return nil, err
+		}
+		timeline = append(timeline, entry)
+	}
+
+	if len(timeline) == 0 {
+		return &IncidentResponse{Incident: toIncidentProto(*incident)}, nil
+	}
+
+	event, err := newOutboxEvent(updatedIncident, eventType)
+	if err != nil {
 		return nil, err
 	}
+
+	err = updateIncident(s.dbClient, req.IncidentID, incident.Status, change, event, timeline)
+	if err != nil {
+		log.Printf("Nie udało się zaktualizować incydentu o ID: %s, błąd: %v\n", req.IncidentID, err)
+		return nil, storageError(err)
+	}
 	log.Printf("Zaktualizowano incydent: %+v\n", updatedIncident)
 
-	s.sqsManager.SendMessage(*updatedIncident, "UPDATE")
+	s.relay.Notify()
+	s.broadcaster.Publish(IncidentChangeType_INCIDENT_CHANGE_TYPE_UPDATED, updatedIncident)
+
+	return &IncidentResponse{Incident: toIncidentProto(updatedIncident)}, nil
+}
+
+// applyAssignments replaces the assignments given in the request and reports whether they differ from the stored ones.
+func applyAssignments(incident *Incident, req *UpdateIncidentRequest) (*IncidentAssignments, bool) {
+	assignments := &IncidentAssignments{
+		LifeguardIDs: incident.AssignedLifeguardIDs,
+		VehicleIDs:   incident.AssignedVehicleIDs,
+	}
+	if req.AssignedLifeguards != nil {
+		assignments.LifeguardIDs = req.AssignedLifeguards.Ids
+	}
+	if req.AssignedVehicles != nil {
+		assignments.VehicleIDs = req.AssignedVehicles.Ids
+	}
+	if slices.Equal(assignments.LifeguardIDs, incident.AssignedLifeguardIDs) && slices.Equal(assignments.VehicleIDs, incident.AssignedVehicleIDs) {
+		return nil, false
+	}
 
-	return &IncidentResponse{Incident: &IncidentProto{
-		IncidentID:   updatedIncident.IncidentID,
-		Title:        updatedIncident.Title,
-		Description:  updatedIncident.Description,
-		Status:       updatedIncident.Status,
-		CreationDate: updatedIncident.CreationDate,
-	}}, nil
+	incident.AssignedLifeguardIDs = assignments.LifeguardIDs
+	incident.AssignedVehicleIDs = assignments.VehicleIDs
+	return assignments, true
 }
 
 func (s *IncidentServer) DeleteIncident(ctx context.Context, req *DeleteIncidentRequest) (*DeleteIncidentResponse, error) {
 	incident, err := getIncident(s.dbClient, req.IncidentID)
 	if err != nil {
 		log.Printf("Nie udało się pobrać incydentu do usunięcia, ID incydentu: %s, błąd: %v\n", req.IncidentID, err)
+		return nil, storageError(err)
+	}
+
+	event, err := newOutboxEvent(*incident, events.IncidentDeleted)
+	if err != nil {
 		return nil, err
 	}
 
-	err = deleteIncident(s.dbClient, req.IncidentID)
+	entry, err := systemTimelineItem(req.IncidentID, "Usunięto incydent", time.Now())
 	if err != nil {
-		log.Printf("Nie udało się usunąć incydentu o ID: %s, błąd: %v\n", req.IncidentID, err)
 		return nil, err
 	}
+
+	err = deleteIncident(s.dbClient, *incident, event, entry)
+	if err != nil {
+		log.Printf("Nie udało się usunąć incydentu o ID: %s, błąd: %v\n", req.IncidentID, err)
+		return nil, storageError(err)
+	}
 	log.Printf("Usunięgo incydent o ID %s\n", req.IncidentID)
 
-	s.sqsManager.SendMessage(*incident, "DELETE")
+	s.relay.Notify()
+	s.broadcaster.Publish(IncidentChangeType_INCIDENT_CHANGE_TYPE_DELETED, *incident)
 
 	return &DeleteIncidentResponse{Success: true}, nil
 }
+
+func (s *IncidentServer) ListIncidents(ctx context.Context, req *ListIncidentsRequest) (*ListIncidentsResponse, error) {
+	filter := IncidentFilter{
+		CreatedFrom: utcTimestamp(req.CreatedFrom),
+		CreatedTo:   utcTimestamp(req.CreatedTo),
+	}
+	if req.Status != IncidentStatus_INCIDENT_STATUS_UNSPECIFIED {
+		incidentStatus, err := resolveIncidentStatus(req.Status, "")
+		if err != nil {
+			return nil, err
+		}
+		filter.Status = incidentStatus
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	indexName := indexForFilter(filter)
+	startKey, err := decodePageToken(req.PageToken, indexName)
+	if err != nil {
+		return nil, fieldViolationError("page_token", err.Error())
+	}
+
+	incidents, lastKey, err := listIncidents(s.dbClient, filter, pageSize, startKey)
+	if err != nil {
+		log.Printf("Nie udało się pobrać listy incydentów, filtr: %+v, błąd: %v\n", filter, err)
+		return nil, err
+	}
+	log.Printf("Pobrano %d incydentów, filtr: %+v\n", len(incidents), filter)
+
+	response := &ListIncidentsResponse{}
+	for _, incident := range incidents {
+		response.Incidents = append(response.Incidents, toIncidentProto(incident))
+		response.Cursors = append(response.Cursors, incidentCursor(indexName, incident))
+	}
+	if lastKey != nil {
+		response.NextPageToken = encodePageToken(indexName, lastKey)
+	}
+
+	return response, nil
+}
+
+func (s *IncidentServer) BatchGetIncidents(ctx context.Context, req *BatchGetIncidentsRequest) (*BatchGetIncidentsResponse, error) {
+	incidents, err := getIncidents(s.dbClient, req.IncidentIds)
+	if err != nil {
+		log.Printf("Nie udało się pobrać incydentów o ID: %v, błąd: %v\n", req.IncidentIds, err)
+		return nil, storageError(err)
+	}
+
+	log.Printf("Pobrano %d z %d żądanych incydentów\n", len(incidents), len(req.IncidentIds))
+
+	response := &BatchGetIncidentsResponse{}
+	for _, incident := range incidents {
+		response.Incidents = append(response.Incidents, toIncidentProto(incident))
+	}
+	return response, nil
+}
+
+func toTimelineEntryProto(item TimelineItem) *TimelineEntry {
+	fromStatus, _ := parseIncidentStatus(item.FromStatus)
+	toStatus, _ := parseIncidentStatus(item.ToStatus)
+
+	return &TimelineEntry{
+		EntryId:      item.EntryID,
+		IncidentID:   item.IncidentID,
+		Type:         TimelineEntryType(TimelineEntryType_value[timelineEntryTypePrefix+item.Type]),
+		CreatedAt:    item.CreatedAt,
+		Author:       item.Author,
+		Text:         item.Text,
+		FromStatus:   fromStatus,
+		ToStatus:     toStatus,
+		LifeguardIds: item.LifeguardIDs,
+		VehicleIds:   item.VehicleIDs,
+	}
+}
+
+func (s *IncidentServer) AddIncidentNote(ctx context.Context, req *AddIncidentNoteRequest) (*TimelineEntryResponse, error) {
+	item, err := newTimelineItem(req.IncidentID, TimelineEntryType_TIMELINE_ENTRY_TYPE_NOTE, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	claims, _ := auth.FromContext(ctx)
+	item.Text = req.Text
+	item.Author = claims.Subject
+
+	err = addTimelineItem(s.dbClient, item)
+	if err != nil {
+		log.Printf("Nie udało się dodać notatki do incydentu o ID: %s, błąd: %v\n", req.IncidentID, err)
+		return nil, storageError(err)
+	}
+	log.Printf("Dodano notatkę do incydentu o ID %s\n", req.IncidentID)
+
+	return &TimelineEntryResponse{Entry: toTimelineEntryProto(item)}, nil
+}
+
+func (s *IncidentServer) GetIncidentTimeline(ctx context.Context, req *GetIncidentTimelineRequest) (*GetIncidentTimelineResponse, error) {
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	startKey, err := decodePageToken(req.PageToken, timelineTableName)
+	if err != nil {
+		return nil, fieldViolationError("page_token", err.Error())
+	}
+
+	timeline, lastKey, err := listTimeline(s.dbClient, req.IncidentID, pageSize, startKey)
+	if err != nil {
+		log.Printf("Nie udało się pobrać osi czasu incydentu o ID: %s, błąd: %v\n", req.IncidentID, err)
+		return nil, err
+	}
+
+	response := &GetIncidentTimelineResponse{}
+	for _, item := range timeline {
+		response.Entries = append(response.Entries, toTimelineEntryProto(item))
+	}
+	if lastKey != nil {
+		response.NextPageToken = encodePageToken(timelineTableName, lastKey)
+	}
+
+	return response, nil
+}
+
+// Only changes made through this server instance are streamed.
+func (s *IncidentServer) WatchIncidents(req *WatchIncidentsRequest, stream IncidentService_WatchIncidentsServer) error {
+	changes, unsubscribe := s.broadcaster.Subscribe(req)
+	defer unsubscribe()
+	log.Printf("Rozpoczęto obserwowanie incydentów, filtr: %+v\n", req)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			log.Println("Zakończono obserwowanie incydentów")
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "Obserwator nie nadążał z odbiorem zmian incydentów")
+			}
+			if err := stream.Send(change); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/szbobrowski/master-thesis/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+const incidentStatusPrefix = "INCIDENT_STATUS_"
+
+var incidentStatusSynonyms = map[string]IncidentStatus{
+	"new":           IncidentStatus_INCIDENT_STATUS_REPORTED,
+	"nowy":          IncidentStatus_INCIDENT_STATUS_REPORTED,
+	"nowe":          IncidentStatus_INCIDENT_STATUS_REPORTED,
+	"open":          IncidentStatus_INCIDENT_STATUS_REPORTED,
+	"otwarty":       IncidentStatus_INCIDENT_STATUS_REPORTED,
+	"zgłoszony":     IncidentStatus_INCIDENT_STATUS_REPORTED,
+	"potwierdzony":  IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED,
+	"in progress":   IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+	"active":        IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+	"ongoing":       IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+	"aktywny":       IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+	"w toku":        IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+	"w trakcie":     IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+	"zadysponowany": IncidentStatus_INCIDENT_STATUS_DISPATCHED,
+	"na miejscu":    IncidentStatus_INCIDENT_STATUS_ON_SCENE,
+	"done":          IncidentStatus_INCIDENT_STATUS_RESOLVED,
+	"rozwiązany":    IncidentStatus_INCIDENT_STATUS_RESOLVED,
+	"zakończony":    IncidentStatus_INCIDENT_STATUS_RESOLVED,
+	"zamknięty":     IncidentStatus_INCIDENT_STATUS_CLOSED,
+	"canceled":      IncidentStatus_INCIDENT_STATUS_CANCELLED,
+	"anulowany":     IncidentStatus_INCIDENT_STATUS_CANCELLED,
+	"odwołany":      IncidentStatus_INCIDENT_STATUS_CANCELLED,
+}
+
+func normalizeStatusKey(value string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-'
+	}), " ")
+}
+
+func parseIncidentStatus(value string) (IncidentStatus, bool) {
+	key := normalizeStatusKey(value)
+	if key == "" {
+		return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED, false
+	}
+
+	for name, number := range IncidentStatus_value {
+		if number == 0 {
+			continue
+		}
+		if key == normalizeStatusKey(name) || key == normalizeStatusKey(strings.TrimPrefix(name, incidentStatusPrefix)) {
+			return IncidentStatus(number), true
+		}
+	}
+
+	status, ok := incidentStatusSynonyms[key]
+	return status, ok
+}
+
+func incidentStatusName(status IncidentStatus) string {
+	return strings.TrimPrefix(status.String(), incidentStatusPrefix)
+}
+
+func resolveIncidentStatus(status IncidentStatus, legacy string) (string, error) {
+	if status != IncidentStatus_INCIDENT_STATUS_UNSPECIFIED {
+		if _, ok := IncidentStatus_name[int32(status)]; !ok {
+			return "", fieldViolationError("status", fmt.Sprintf("Nieznany status incydentu: %d", int32(status)))
+		}
+		return incidentStatusName(status), nil
+	}
+
+	if strings.TrimSpace(legacy) == "" {
+		return "", fieldViolationError("status", "Pole jest wymagane")
+	}
+
+	parsed, ok := parseIncidentStatus(legacy)
+	if !ok {
+		return "", fieldViolationError("legacy_status", fmt.Sprintf("Nieznany status incydentu: %q", legacy))
+	}
+	return incidentStatusName(parsed), nil
+}
+
+func fieldViolationError(field, description string) error {
+	return validation.InvalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+		{Field: field, Description: description},
+	})
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+const watcherBufferSize = 64
+
+type incidentWatcher struct {
+	filter  *WatchIncidentsRequest
+	changes chan *IncidentChange
+}
+
+// IncidentBroadcaster passes incident changes committed by this server to WatchIncidents streams.
+// Changes made by another process are not seen, which is why the service runs as a single replica, see InstanceLease.
+// A watcher that does not keep up is disconnected instead of slowing down writes.
+type IncidentBroadcaster struct {
+	mu       sync.Mutex
+	watchers map[*incidentWatcher]struct{}
+}
+
+func NewIncidentBroadcaster() *IncidentBroadcaster {
+	return &IncidentBroadcaster{watchers: map[*incidentWatcher]struct{}{}}
+}
+
+// Subscribe returns a channel of matching changes, closed when the watcher is dropped, and a function that unsubscribes.
+func (b *IncidentBroadcaster) Subscribe(filter *WatchIncidentsRequest) (<-chan *IncidentChange, func()) {
+	watcher := &incidentWatcher{
+		filter:  filter,
+		changes: make(chan *IncidentChange, watcherBufferSize),
+	}
+
+	b.mu.Lock()
+	b.watchers[watcher] = struct{}{}
+	b.mu.Unlock()
+
+	return watcher.changes, func() { b.remove(watcher) }
+}
+
+func (b *IncidentBroadcaster) remove(watcher *incidentWatcher) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.watchers[watcher]; ok {
+		delete(b.watchers, watcher)
+		close(watcher.changes)
+	}
+}
+
+func (b *IncidentBroadcaster) Publish(changeType IncidentChangeType, incident Incident) {
+	change := &IncidentChange{
+		Type:      changeType,
+		Incident:  toIncidentProto(incident),
+		ChangedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for watcher := range b.watchers {
+		if !matchesWatchFilter(watcher.filter, change.Incident) {
+			continue
+		}
+		select {
+		case watcher.changes <- change:
+		default:
+			log.Println("Obserwator zmian incydentów nie nadąża z odbiorem, rozłączanie")
+			delete(b.watchers, watcher)
+			close(watcher.changes)
+		}
+	}
+}
+
+func matchesWatchFilter(filter *WatchIncidentsRequest, incident *IncidentProto) bool {
+	if filter.Status != IncidentStatus_INCIDENT_STATUS_UNSPECIFIED && filter.Status != incident.Status {
+		return false
+	}
+	if filter.Severity != IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED && filter.Severity != incident.Severity {
+		return false
+	}
+	if filter.Zone != "" && !strings.EqualFold(filter.Zone, incident.GetLocation().GetZone()) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const (
+	leaseTableName     = "ServiceLeases"
+	instanceLeaseName  = "incident-notifier"
+	leaseDuration      = 30 * time.Second
+	leaseRenewInterval = 10 * time.Second
+)
+
+var errLeaseHeld = errors.New("Dzierżawa jest utrzymywana przez inną instancję")
+
+// InstanceLease keeps a single incident-notifier replica running. WatchIncidents streams only see changes
+// committed by the replica serving them, so a second replica would silently hide changes from its watchers.
+// A replica that loses the lease stops instead of serving incomplete streams.
+type InstanceLease struct {
+	dbClient  *dynamodb.Client
+	owner     string
+	renewedAt time.Time
+}
+
+func newLeaseOwner() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "incident-notifier"
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("Nie udało się wygenerować identyfikatora instancji: %w", err)
+	}
+	return hostname + "-" + hex.EncodeToString(suffix), nil
+}
+
+// acquireInstanceLease waits until no other replica holds the lease, e.g. until the previous one has stopped
+// during a rollout.
+func acquireInstanceLease(client *dynamodb.Client) (*InstanceLease, error) {
+	owner, err := newLeaseOwner()
+	if err != nil {
+		return nil, err
+	}
+
+	lease := &InstanceLease{dbClient: client, owner: owner}
+	for {
+		err := lease.renew()
+		if err == nil {
+			log.Printf("Instancja %s przejęła dzierżawę %s\n", owner, instanceLeaseName)
+			return lease, nil
+		}
+		if !errors.Is(err, errLeaseHeld) {
+			return nil, err
+		}
+
+		log.Printf("Inna instancja incident-notifier jest aktywna, ponowna próba przejęcia dzierżawy za %s\n", leaseRenewInterval)
+		time.Sleep(leaseRenewInterval)
+	}
+}
+
+func (l *InstanceLease) renew() error {
+	now := time.Now()
+	_, err := l.dbClient.PutItem(context.TODO(), &dynamodb.PutItemInput{
+		TableName: aws.String(leaseTableName),
+		Item: map[string]types.AttributeValue{
+			"LeaseName": &types.AttributeValueMemberS{Value: instanceLeaseName},
+			"Owner":     &types.AttributeValueMemberS{Value: l.owner},
+			"ExpiresAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Add(leaseDuration).Unix(), 10)},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(LeaseName) OR #owner = :owner OR ExpiresAt < :now"),
+		ExpressionAttributeNames: map[string]string{
+			"#owner": "Owner",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":owner": &types.AttributeValueMemberS{Value: l.owner},
+			":now":   &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)},
+		},
+	})
+
+	var ccf *types.ConditionalCheckFailedException
+	if errors.As(err, &ccf) {
+		return errLeaseHeld
+	}
+	if err != nil {
+		return err
+	}
+
+	l.renewedAt = now
+	return nil
+}
+
+// Run renews the lease until ctx is cancelled and stops the process once the lease may have passed to another replica.
+func (l *InstanceLease) Run(ctx context.Context) {
+	ticker := time.NewTicker(leaseRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := l.renew()
+		if errors.Is(err, errLeaseHeld) {
+			log.Fatalf("Dzierżawa %s została przejęta przez inną instancję", instanceLeaseName)
+		}
+		if err != nil {
+			log.Printf("Nie udało się odnowić dzierżawy %s: %v\n", instanceLeaseName, err)
+			if time.Since(l.renewedAt) >= leaseDuration {
+				log.Fatalf("Dzierżawa %s wygasła", instanceLeaseName)
+			}
+		}
+	}
+}
+
+func ensureLeaseTable(client *dynamodb.Client) error {
+	_, err := client.DescribeTable(context.TODO(), &dynamodb.DescribeTableInput{
+		TableName: aws.String(leaseTableName),
+	})
+	if err == nil {
+		return nil
+	}
+
+	var nfe *types.ResourceNotFoundException
+	if !errors.As(err, &nfe) {
+		return err
+	}
+
+	_, err = client.CreateTable(context.TODO(), &dynamodb.CreateTableInput{
+		TableName: aws.String(leaseTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("LeaseName"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("LeaseName"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		ProvisionedThroughput: &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// The lease is taken right after start-up, so the table has to be usable before this returns.
+	err = dynamodb.NewTableExistsWaiter(client).Wait(context.TODO(), &dynamodb.DescribeTableInput{
+		TableName: aws.String(leaseTableName),
+	}, tableActiveTimeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Utworzono tabelę %s\n", leaseTableName)
+	return nil
+}
@@ -10,13 +10,20 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/szbobrowski/master-thesis/auth"
+	"github.com/szbobrowski/master-thesis/auth/mtls"
+	"github.com/szbobrowski/master-thesis/validation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
 const (
-	tableName = "Incidents"
-	queueName = "IncidentsQueue"
+	tableName            = "Incidents"
+	outboxTableName      = "IncidentOutbox"
+	idempotencyTableName = "IncidentIdempotencyKeys"
+	timelineTableName    = "IncidentTimeline"
+	queueName            = "IncidentsQueue"
+	producerName         = "incident-notifier"
 )
 
 func main() {
@@ -44,6 +51,11 @@ func main() {
 		log.Fatalf("Nie udało się pobrać konfiguracji SDK, %v", err)
 	}
 
+	signer, err := auth.SignerFromEnv()
+	if err != nil {
+		log.Fatalf("Nie udało się wczytać sekretu tokenów dostępu, %v", err)
+	}
+
 	dynamoClient := dynamodb.NewFromConfig(cfg)
 	sqsClient := sqs.NewFromConfig(cfg)
 
@@ -64,6 +76,68 @@ func main() {
 		}
 	} else {
 		fmt.Println("Tabela Incidents już istnieje.")
+
+		err = ensureIndexes(dynamoClient)
+		if err != nil {
+			log.Fatalf("Nie udało się utworzyć indeksów tabeli Incidents, %v", err)
+		}
+	}
+
+	err = ensureOutboxTable(dynamoClient)
+	if err != nil {
+		log.Fatalf("Nie udało się utworzyć tabeli %s, %v", outboxTableName, err)
+	}
+
+	err = ensureShortCodeTable(dynamoClient)
+	if err != nil {
+		log.Fatalf("Nie udało się utworzyć tabeli %s, %v", shortCodeTableName, err)
+	}
+
+	err = ensureLeaseTable(dynamoClient)
+	if err != nil {
+		log.Fatalf("Nie udało się utworzyć tabeli %s, %v", leaseTableName, err)
+	}
+
+	// WatchIncidents only sees changes made by this process, so exactly one replica may run at a time.
+	lease, err := acquireInstanceLease(dynamoClient)
+	if err != nil {
+		log.Fatalf("Nie udało się przejąć dzierżawy instancji, %v", err)
+	}
+	go lease.Run(context.Background())
+
+	err = ensureIdempotencyTable(dynamoClient)
+	if err != nil {
+		log.Fatalf("Nie udało się utworzyć tabeli %s, %v", idempotencyTableName, err)
+	}
+
+	err = ensureTimelineTable(dynamoClient)
+	if err != nil {
+		log.Fatalf("Nie udało się utworzyć tabeli %s, %v", timelineTableName, err)
+	}
+
+	// A new table and the indexes added above are built asynchronously.
+	_, err = waitForTableActive(dynamoClient)
+	if err != nil {
+		log.Fatalf("Tabela Incidents nie jest gotowa, %v", err)
+	}
+
+	err = normalizeIncidentStatuses(dynamoClient)
+	if err != nil {
+		log.Fatalf("Nie udało się znormalizować statusów incydentów, %v", err)
+	}
+
+	err = backfillEntityType(dynamoClient)
+	if err != nil {
+		log.Fatalf("Nie udało się uzupełnić atrybutu EntityType incydentów, %v", err)
+	}
+
+	tlsConfig, err := mtls.ConfigFromEnv("incident-notifier", "client-handler")
+	if err != nil {
+		log.Fatalf("Niepoprawna konfiguracja TLS, %v", err)
+	}
+	creds, err := mtls.Load(context.Background(), tlsConfig)
+	if err != nil {
+		log.Fatalf("Nie udało się wczytać certyfikatów TLS, %v", err)
 	}
 
 	lis, err := net.Listen("tcp", ":50052")
@@ -71,8 +145,20 @@ func main() {
 		log.Fatalf("Nie udało się rozpocząć nasłuchiwania na porcie 50052: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	incidentServer := NewIncidentServer(dynamoClient, sqsManager)
+	grpcServer := grpc.NewServer(
+		creds.ServerOption(),
+		grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(signer, permissions), validation.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(signer, permissions), validation.StreamServerInterceptor()),
+	)
+	idGenerator, err := newIncidentIDGenerator()
+	if err != nil {
+		log.Fatalf("Nie udało się utworzyć generatora identyfikatorów incydentów, %v", err)
+	}
+
+	relay := NewOutboxRelay(dynamoClient, sqsManager)
+	go relay.Run(context.Background())
+
+	incidentServer := NewIncidentServer(dynamoClient, relay, idGenerator, NewIncidentBroadcaster())
 
 	RegisterIncidentServiceServer(grpcServer, incidentServer)
 
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+const (
+	relayPollInterval = 5 * time.Second
+	relayBatchSize    = 25
+	relayBaseBackoff  = time.Second
+	relayMaxBackoff   = 5 * time.Minute
+)
+
+// OutboxRelay publishes events written to the outbox table to SQS.
+// An event stays pending until SQS accepts it, so delivery is at-least-once.
+type OutboxRelay struct {
+	dbClient   *dynamodb.Client
+	sqsManager *SQSManager
+	wakeup     chan struct{}
+}
+
+func NewOutboxRelay(client *dynamodb.Client, queue *SQSManager) *OutboxRelay {
+	return &OutboxRelay{
+		dbClient:   client,
+		sqsManager: queue,
+		wakeup:     make(chan struct{}, 1),
+	}
+}
+
+// Notify makes the relay publish pending events right away instead of waiting for the next poll.
+func (r *OutboxRelay) Notify() {
+	select {
+	case r.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(relayPollInterval)
+	defer ticker.Stop()
+
+	for {
+		r.publishPending()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wakeup:
+		}
+	}
+}
+
+func (r *OutboxRelay) publishPending() {
+	for {
+		pending, err := listPendingEvents(r.dbClient, relayBatchSize)
+		if err != nil {
+			log.Printf("Nie udało się pobrać oczekujących zdarzeń z tabeli %s: %v\n", outboxTableName, err)
+			return
+		}
+
+		failed := 0
+		for _, event := range pending {
+			if !r.publish(event) {
+				failed++
+			}
+		}
+
+		// Failed events are postponed, so another query only makes sense if the whole batch was sent.
+		if len(pending) < relayBatchSize || failed > 0 {
+			return
+		}
+	}
+}
+
+func (r *OutboxRelay) publish(event OutboxEvent) bool {
+	err := r.sqsManager.SendMessage(event)
+	if err != nil {
+		nextAttemptAt := time.Now().Add(retryBackoff(event.Attempts + 1))
+		log.Printf("Nie udało się opublikować zdarzenia %s (próba %d), kolejna próba o %s: %v\n", event.EventID, event.Attempts+1, nextAttemptAt.Format(time.RFC3339), err)
+
+		err = markEventFailed(r.dbClient, event.EventID, nextAttemptAt, err)
+		if err != nil {
+			log.Printf("Nie udało się zapisać nieudanej próby publikacji zdarzenia %s: %v\n", event.EventID, err)
+		}
+		return false
+	}
+
+	// If this fails the event is published again later, which at-least-once delivery allows.
+	err = markEventSent(r.dbClient, event.EventID)
+	if err != nil {
+		log.Printf("Nie udało się oznaczyć zdarzenia %s jako wysłanego: %v\n", event.EventID, err)
+	}
+	return true
+}
+
+func retryBackoff(attempt int) time.Duration {
+	backoff := relayBaseBackoff
+	for i := 1; i < attempt && backoff < relayMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > relayMaxBackoff {
+		backoff = relayMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/szbobrowski/master-thesis/events"
+)
+
+const (
+	outboxPendingIndexName = "OutboxPendingIndex"
+	outboxPendingStatus    = "PENDING"
+	outboxRetention        = 7 * 24 * time.Hour
+)
+
+type OutboxEvent struct {
+	EventID       string
+	EventType     events.EventType
+	SchemaVersion int
+	IncidentID    string
+	Body          string
+	CreatedAt     string
+	Attempts      int
+}
+
+func incidentEventData(incident Incident) events.IncidentData {
+	data := events.IncidentData{
+		IncidentID:           incident.IncidentID,
+		ShortCode:            incident.ShortCode,
+		Title:                incident.Title,
+		Description:          incident.Description,
+		Status:               incident.Status,
+		CreationDate:         incident.CreationDate,
+		StatusChangedAt:      incident.StatusChangedAt,
+		ResolutionNote:       incident.ResolutionNote,
+		CancellationReason:   incident.CancellationReason,
+		Severity:             incident.Severity,
+		Priority:             incident.Priority,
+		Category:             incident.Category,
+		Zone:                 incident.Zone,
+		PeopleInvolved:       incident.PeopleInvolved,
+		AssignedLifeguardIDs: incident.AssignedLifeguardIDs,
+		AssignedVehicleIDs:   incident.AssignedVehicleIDs,
+	}
+	if incident.Latitude != nil && incident.Longitude != nil {
+		data.Coordinates = &events.Coordinates{
+			Latitude:  *incident.Latitude,
+			Longitude: *incident.Longitude,
+		}
+	}
+	if reporter := toReporterProto(incident); reporter != nil {
+		data.Reporter = &events.Reporter{
+			Name:  reporter.Name,
+			Phone: reporter.Phone,
+			Email: reporter.Email,
+		}
+	}
+	return data
+}
+
+func newOutboxEvent(incident Incident, eventType events.EventType) (OutboxEvent, error) {
+	event, err := events.NewIncidentEvent(eventType, producerName, incidentEventData(incident))
+	if err != nil {
+		return OutboxEvent{}, err
+	}
+
+	body, err := event.Marshal()
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("Nie udało się zserializować zdarzenia: %v", err)
+	}
+
+	return OutboxEvent{
+		EventID:       event.ID,
+		EventType:     event.Type,
+		SchemaVersion: event.SchemaVersion,
+		IncidentID:    incident.IncidentID,
+		Body:          string(body),
+		CreatedAt:     event.Time.UTC().Format(sortableTimeFormat),
+	}, nil
+}
+
+// The returned put is meant to be part of the same transaction as the incident change the event describes.
+func outboxPut(event OutboxEvent) *types.Put {
+	return &types.Put{
+		TableName: aws.String(outboxTableName),
+		Item: map[string]types.AttributeValue{
+			"EventID":       &types.AttributeValueMemberS{Value: event.EventID},
+			"EventType":     &types.AttributeValueMemberS{Value: string(event.EventType)},
+			"SchemaVersion": &types.AttributeValueMemberN{Value: strconv.Itoa(event.SchemaVersion)},
+			"IncidentID":    &types.AttributeValueMemberS{Value: event.IncidentID},
+			"Body":          &types.AttributeValueMemberS{Value: event.Body},
+			"CreatedAt":     &types.AttributeValueMemberS{Value: event.CreatedAt},
+			"OutboxStatus":  &types.AttributeValueMemberS{Value: outboxPendingStatus},
+			"Attempts":      &types.AttributeValueMemberN{Value: "0"},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(EventID)"),
+	}
+}
+
+func outboxEventFromItem(item map[string]types.AttributeValue) OutboxEvent {
+	event := OutboxEvent{
+		EventID:    optionalString(item, "EventID"),
+		EventType:  events.EventType(optionalString(item, "EventType")),
+		IncidentID: optionalString(item, "IncidentID"),
+		Body:       optionalString(item, "Body"),
+		CreatedAt:  optionalString(item, "CreatedAt"),
+	}
+	if value, ok := item["SchemaVersion"].(*types.AttributeValueMemberN); ok {
+		event.SchemaVersion, _ = strconv.Atoi(value.Value)
+	}
+	if value, ok := item["Attempts"].(*types.AttributeValueMemberN); ok {
+		event.Attempts, _ = strconv.Atoi(value.Value)
+	}
+	return event
+}
+
+// Pending events are the only items with the OutboxStatus attribute, so the index stays small.
+func createOutboxTable(client *dynamodb.Client) error {
+	_, err := client.CreateTable(context.TODO(), &dynamodb.CreateTableInput{
+		TableName: aws.String(outboxTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("EventID"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("OutboxStatus"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("CreatedAt"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("EventID"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
+			{
+				IndexName: aws.String(outboxPendingIndexName),
+				KeySchema: []types.KeySchemaElement{
+					{
+						AttributeName: aws.String("OutboxStatus"),
+						KeyType:       types.KeyTypeHash,
+					},
+					{
+						AttributeName: aws.String("CreatedAt"),
+						KeyType:       types.KeyTypeRange,
+					},
+				},
+				Projection: &types.Projection{
+					ProjectionType: types.ProjectionTypeAll,
+				},
+				ProvisionedThroughput: &types.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(5),
+					WriteCapacityUnits: aws.Int64(5),
+				},
+			},
+		},
+		ProvisionedThroughput: &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(5),
+			WriteCapacityUnits: aws.Int64(5),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.UpdateTimeToLive(context.TODO(), &dynamodb.UpdateTimeToLiveInput{
+		TableName: aws.String(outboxTableName),
+		TimeToLiveSpecification: &types.TimeToLiveSpecification{
+			AttributeName: aws.String("ExpiresAt"),
+			Enabled:       aws.Bool(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Nie udało się włączyć TTL w tabeli %s: %v", outboxTableName, err)
+	}
+
+	return nil
+}
+
+func ensureOutboxTable(client *dynamodb.Client) error {
+	_, err := client.DescribeTable(context.TODO(), &dynamodb.DescribeTableInput{
+		TableName: aws.String(outboxTableName),
+	})
+	if err == nil {
+		return nil
+	}
+
+	var nfe *types.ResourceNotFoundException
+	if !errors.As(err, &nfe) {
+		return err
+	}
+
+	err = createOutboxTable(client)
+	if err != nil {
+		return err
+	}
+	log.Printf("Utworzono tabelę %s\n", outboxTableName)
+	return nil
+}
+
+// Returns the oldest pending events whose retry delay has passed.
+// The query limit applies before the filter, so pages are read until enough events are found,
+// otherwise postponed events at the front of the index would hide the newer ones.
+func listPendingEvents(client *dynamodb.Client, limit int) ([]OutboxEvent, error) {
+	paginator := dynamodb.NewQueryPaginator(client, &dynamodb.QueryInput{
+		TableName:              aws.String(outboxTableName),
+		IndexName:              aws.String(outboxPendingIndexName),
+		KeyConditionExpression: aws.String("OutboxStatus = :pending"),
+		FilterExpression:       aws.String("attribute_not_exists(NextAttemptAt) OR NextAttemptAt <= :now"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pending": &types.AttributeValueMemberS{Value: outboxPendingStatus},
+			":now":     &types.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().Unix(), 10)},
+		},
+		ScanIndexForward: aws.Bool(true),
+	})
+
+	var pending []OutboxEvent
+	for paginator.HasMorePages() && len(pending) < limit {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			pending = append(pending, outboxEventFromItem(item))
+		}
+	}
+
+	if len(pending) > limit {
+		pending = pending[:limit]
+	}
+	return pending, nil
+}
+
+// Removing OutboxStatus takes the event out of the pending index; the item itself expires after the retention period.
+func markEventSent(client *dynamodb.Client, eventID string) error {
+	now := time.Now().UTC()
+	_, err := client.UpdateItem(context.TODO(), &dynamodb.UpdateItemInput{
+		TableName: aws.String(outboxTableName),
+		Key: map[string]types.AttributeValue{
+			"EventID": &types.AttributeValueMemberS{Value: eventID},
+		},
+		UpdateExpression: aws.String("SET SentAt = :sentAt, ExpiresAt = :expiresAt REMOVE OutboxStatus, NextAttemptAt, LastError"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":sentAt":    &types.AttributeValueMemberS{Value: now.Format(time.RFC3339)},
+			":expiresAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Add(outboxRetention).Unix(), 10)},
+		},
+	})
+	return err
+}
+
+func markEventFailed(client *dynamodb.Client, eventID string, nextAttemptAt time.Time, cause error) error {
+	_, err := client.UpdateItem(context.TODO(), &dynamodb.UpdateItemInput{
+		TableName: aws.String(outboxTableName),
+		Key: map[string]types.AttributeValue{
+			"EventID": &types.AttributeValueMemberS{Value: eventID},
+		},
+		UpdateExpression: aws.String("SET NextAttemptAt = :nextAttemptAt, LastError = :lastError ADD Attempts :one"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":nextAttemptAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(nextAttemptAt.Unix(), 10)},
+			":lastError":     &types.AttributeValueMemberS{Value: cause.Error()},
+			":one":           &types.AttributeValueMemberN{Value: "1"},
+		},
+	})
+	return err
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const defaultPageSize = 20
+
+var errInvalidPageToken = errors.New("Niepoprawny token strony")
+
+// A page token is the DynamoDB LastEvaluatedKey of the queried index, serialized as base64 JSON.
+// All key attributes of the table and its indexes are strings.
+type pageToken struct {
+	Index string            `json:"index"`
+	Key   map[string]string `json:"key"`
+}
+
+func encodePageToken(indexName string, key map[string]types.AttributeValue) string {
+	token := pageToken{Index: indexName, Key: map[string]string{}}
+	for name, value := range key {
+		if s, ok := value.(*types.AttributeValueMemberS); ok {
+			token.Key[name] = s.Value
+		}
+	}
+
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(encoded, indexName string) (map[string]types.AttributeValue, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil || token.Index != indexName || len(token.Key) == 0 {
+		return nil, errInvalidPageToken
+	}
+
+	key := map[string]types.AttributeValue{}
+	for name, value := range token.Key {
+		key[name] = &types.AttributeValueMemberS{Value: value}
+	}
+	return key, nil
+}
+
+func incidentCursor(indexName string, incident Incident) string {
+	key := map[string]types.AttributeValue{
+		"IncidentID":   &types.AttributeValueMemberS{Value: incident.IncidentID},
+		"CreationDate": &types.AttributeValueMemberS{Value: incident.CreationDate},
+	}
+	if indexName == statusIndexName {
+		key["Status"] = &types.AttributeValueMemberS{Value: incident.Status}
+	} else {
+		key["EntityType"] = &types.AttributeValueMemberS{Value: incidentEntityType}
+	}
+	return encodePageToken(indexName, key)
+}
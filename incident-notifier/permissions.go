@@ -0,0 +1,22 @@
+package main
+
+import "github.com/szbobrowski/master-thesis/auth"
+
+var (
+	staff    = auth.StaffRoles
+	managers = []auth.Role{auth.RoleSupervisor, auth.RoleAdmin}
+)
+
+// Anyone with a token may report an incident, public reporters can do nothing else. client-handler watches
+// incidents with its own service token to feed the SSE stream.
+var permissions = auth.Permissions{
+	"/main.IncidentService/CreateIncident":      auth.UserRoles,
+	"/main.IncidentService/GetIncident":         staff,
+	"/main.IncidentService/UpdateIncident":      staff,
+	"/main.IncidentService/DeleteIncident":      managers,
+	"/main.IncidentService/ListIncidents":       staff,
+	"/main.IncidentService/BatchGetIncidents":   staff,
+	"/main.IncidentService/AddIncidentNote":     staff,
+	"/main.IncidentService/GetIncidentTimeline": staff,
+	"/main.IncidentService/WatchIncidents":      {auth.RoleDispatcher, auth.RoleLifeguard, auth.RoleSupervisor, auth.RoleAdmin, auth.RoleService},
+}
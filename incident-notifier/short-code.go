@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const shortCodeTableName = "IncidentShortCodes"
+
+var errShortCodeTaken = errors.New("Krótki kod incydentu jest już używany przez aktywny incydent")
+
+// Short codes are read over the radio, so two active incidents must never share one. The code is reserved in
+// its own table in the transaction creating the incident and released by the one closing, cancelling or
+// deleting it; 20 bits of a code are plenty for the incidents open at one time, but not for all incidents ever.
+func shortCodeReservation(incident Incident) *types.Put {
+	return &types.Put{
+		TableName: aws.String(shortCodeTableName),
+		Item: map[string]types.AttributeValue{
+			"ShortCode":  &types.AttributeValueMemberS{Value: incident.ShortCode},
+			"IncidentID": &types.AttributeValueMemberS{Value: incident.IncidentID},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(ShortCode)"),
+	}
+}
+
+// Incidents created before codes were reserved hold no reservation, they release nothing.
+func shortCodeRelease(incident Incident) *types.Delete {
+	if !incident.ShortCodeReserved {
+		return nil
+	}
+	return &types.Delete{
+		TableName: aws.String(shortCodeTableName),
+		Key: map[string]types.AttributeValue{
+			"ShortCode": &types.AttributeValueMemberS{Value: incident.ShortCode},
+		},
+		ConditionExpression: aws.String("IncidentID = :incidentID"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":incidentID": &types.AttributeValueMemberS{Value: incident.IncidentID},
+		},
+	}
+}
+
+// A closed or cancelled incident cannot change its status any more, so its code may be given to a new one.
+func releasesShortCode(status IncidentStatus) bool {
+	return status == IncidentStatus_INCIDENT_STATUS_CLOSED || status == IncidentStatus_INCIDENT_STATUS_CANCELLED
+}
+
+func ensureShortCodeTable(client *dynamodb.Client) error {
+	_, err := client.DescribeTable(context.TODO(), &dynamodb.DescribeTableInput{
+		TableName: aws.String(shortCodeTableName),
+	})
+	if err == nil {
+		return nil
+	}
+
+	var nfe *types.ResourceNotFoundException
+	if !errors.As(err, &nfe) {
+		return err
+	}
+
+	_, err = client.CreateTable(context.TODO(), &dynamodb.CreateTableInput{
+		TableName: aws.String(shortCodeTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("ShortCode"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("ShortCode"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		ProvisionedThroughput: &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(5),
+			WriteCapacityUnits: aws.Int64(5),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Utworzono tabelę %s\n", shortCodeTableName)
+	return nil
+}
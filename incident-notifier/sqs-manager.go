@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -55,21 +56,26 @@ func (m *SQSManager) ensureQueueExists() error {
 	return nil
 }
 
-func (m *SQSManager) SendMessage(incident Incident, operation string) error {
-	messageBody := fmt.Sprintf(
-		`{"operation":"%s","incidentId":"%s","title":"%s","description":"%s","status":"%s","creationDate":"%s"}`,
-		operation, incident.IncidentID, incident.Title, incident.Description, incident.Status, incident.CreationDate,
-	)
-
+func (m *SQSManager) SendMessage(event OutboxEvent) error {
 	_, err := m.client.SendMessage(context.TODO(), &sqs.SendMessageInput{
 		QueueUrl:    &m.queueURL,
-		MessageBody: &messageBody,
+		MessageBody: aws.String(event.Body),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"EventType": {
+				DataType:    aws.String("String"),
+				StringValue: aws.String(string(event.EventType)),
+			},
+			"SchemaVersion": {
+				DataType:    aws.String("Number"),
+				StringValue: aws.String(strconv.Itoa(event.SchemaVersion)),
+			},
+		},
 	})
 
 	if err != nil {
 		return fmt.Errorf("Nie udało się wysłać wiadomości na kolejkę SQS: %v", err)
 	}
 
-	log.Printf("Incydent o ID %s z operacją %s wysłany do kolejki SQS %s\n", incident.IncidentID, operation, queueName)
+	log.Printf("Zdarzenie %s (%s) dla incydentu o ID %s wysłane do kolejki SQS %s\n", event.EventID, event.EventType, event.IncidentID, queueName)
 	return nil
 }
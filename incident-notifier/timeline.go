@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const timelineEntryTypePrefix = "TIMELINE_ENTRY_TYPE_"
+
+// Timeline entries live in a companion table partitioned by the same IncidentID and sorted by EntryID, which
+// starts with the creation time. The Incidents table was created with IncidentID as its only key and DynamoDB
+// cannot add a sort key to an existing table, so the entries cannot share the incident's own partition there.
+// The companion table serves the same purpose: one Query reads the entries in order, and they are written in
+// the same transaction as the change they describe.
+type TimelineItem struct {
+	IncidentID   string
+	EntryID      string
+	Type         string
+	CreatedAt    string
+	Author       string  `dynamodbav:",omitempty"`
+	Text         string  `dynamodbav:",omitempty"`
+	FromStatus   string  `dynamodbav:",omitempty"`
+	ToStatus     string  `dynamodbav:",omitempty"`
+	LifeguardIDs []int64 `dynamodbav:",omitempty"`
+	VehicleIDs   []int64 `dynamodbav:",omitempty"`
+}
+
+func newTimelineItem(incidentID string, entryType TimelineEntryType, now time.Time) (TimelineItem, error) {
+	var suffix [4]byte
+	if _, err := rand.Read(suffix[:]); err != nil {
+		return TimelineItem{}, fmt.Errorf("Nie udało się wygenerować identyfikatora wpisu osi czasu: %w", err)
+	}
+
+	return TimelineItem{
+		IncidentID: incidentID,
+		EntryID:    now.UTC().Format(sortableTimeFormat) + "#" + hex.EncodeToString(suffix[:]),
+		Type:       strings.TrimPrefix(entryType.String(), timelineEntryTypePrefix),
+		CreatedAt:  now.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func systemTimelineItem(incidentID, text string, now time.Time) (TimelineItem, error) {
+	item, err := newTimelineItem(incidentID, TimelineEntryType_TIMELINE_ENTRY_TYPE_SYSTEM, now)
+	item.Text = text
+	return item, err
+}
+
+// Entries recorded when an incident is reported: the report itself and the resources assigned to it.
+func creationTimeline(incident Incident, now time.Time) ([]TimelineItem, error) {
+	reported, err := systemTimelineItem(incident.IncidentID, "Zgłoszono incydent", now)
+	if err != nil {
+		return nil, err
+	}
+	timeline := []TimelineItem{reported}
+
+	if len(incident.AssignedLifeguardIDs) > 0 || len(incident.AssignedVehicleIDs) > 0 {
+		assignment, err := assignmentTimelineItem(incident, now)
+		if err != nil {
+			return nil, err
+		}
+		timeline = append(timeline, assignment)
+	}
+
+	return timeline, nil
+}
+
+// An assignment entry lists all resources assigned to the incident after the change.
+func assignmentTimelineItem(incident Incident, now time.Time) (TimelineItem, error) {
+	item, err := newTimelineItem(incident.IncidentID, TimelineEntryType_TIMELINE_ENTRY_TYPE_ASSIGNMENT, now)
+	item.LifeguardIDs = incident.AssignedLifeguardIDs
+	item.VehicleIDs = incident.AssignedVehicleIDs
+	return item, err
+}
+
+func statusChangeTimelineItem(incidentID string, transition StatusTransition, now time.Time) (TimelineItem, error) {
+	item, err := newTimelineItem(incidentID, TimelineEntryType_TIMELINE_ENTRY_TYPE_STATUS_CHANGE, now)
+	item.FromStatus = transition.From
+	item.ToStatus = transition.To
+	item.Text = transition.ResolutionNote
+	if item.Text == "" {
+		item.Text = transition.CancellationReason
+	}
+	return item, err
+}
+
+func timelinePut(item TimelineItem) (types.TransactWriteItem, error) {
+	attributes, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return types.TransactWriteItem{}, fmt.Errorf("Nie udało się zserializować wpisu osi czasu: %v", err)
+	}
+
+	return types.TransactWriteItem{
+		Put: &types.Put{
+			TableName:           aws.String(timelineTableName),
+			Item:                attributes,
+			ConditionExpression: aws.String("attribute_not_exists(EntryID)"),
+		},
+	}, nil
+}
+
+func timelinePuts(timeline []TimelineItem) ([]types.TransactWriteItem, error) {
+	var puts []types.TransactWriteItem
+	for _, item := range timeline {
+		put, err := timelinePut(item)
+		if err != nil {
+			return nil, err
+		}
+		puts = append(puts, put)
+	}
+	return puts, nil
+}
+
+// Notes can only be added to existing incidents.
+func addTimelineItem(client *dynamodb.Client, item TimelineItem) error {
+	put, err := timelinePut(item)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.TransactWriteItems(context.TODO(), &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				ConditionCheck: &types.ConditionCheck{
+					TableName: aws.String(tableName),
+					Key: map[string]types.AttributeValue{
+						"IncidentID": &types.AttributeValueMemberS{Value: item.IncidentID},
+					},
+					ConditionExpression: aws.String("attribute_exists(IncidentID)"),
+				},
+			},
+			put,
+		},
+	})
+	if conditionFailed(err, 0) {
+		return fmt.Errorf("%w o ID: %s", errIncidentNotFound, item.IncidentID)
+	}
+	return err
+}
+
+func listTimeline(client *dynamodb.Client, incidentID string, limit int32, startKey map[string]types.AttributeValue) ([]TimelineItem, map[string]types.AttributeValue, error) {
+	result, err := client.Query(context.TODO(), &dynamodb.QueryInput{
+		TableName:              aws.String(timelineTableName),
+		KeyConditionExpression: aws.String("IncidentID = :incidentID"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":incidentID": &types.AttributeValueMemberS{Value: incidentID},
+		},
+		ScanIndexForward:  aws.Bool(true),
+		Limit:             aws.Int32(limit),
+		ExclusiveStartKey: startKey,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var timeline []TimelineItem
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &timeline); err != nil {
+		return nil, nil, fmt.Errorf("Niepoprawny format wpisu osi czasu: %v", err)
+	}
+	return timeline, result.LastEvaluatedKey, nil
+}
+
+func ensureTimelineTable(client *dynamodb.Client) error {
+	_, err := client.DescribeTable(context.TODO(), &dynamodb.DescribeTableInput{
+		TableName: aws.String(timelineTableName),
+	})
+	if err == nil {
+		return nil
+	}
+
+	var nfe *types.ResourceNotFoundException
+	if !errors.As(err, &nfe) {
+		return err
+	}
+
+	_, err = client.CreateTable(context.TODO(), &dynamodb.CreateTableInput{
+		TableName: aws.String(timelineTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("IncidentID"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("EntryID"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("IncidentID"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("EntryID"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		ProvisionedThroughput: &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(5),
+			WriteCapacityUnits: aws.Int64(5),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Utworzono tabelę %s\n", timelineTableName)
+	return nil
+}
@@ -0,0 +1,230 @@
+// Package validation enforces the declarative field rules of validation.proto on the requests received by
+// the gRPC services.
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var dateFormats = map[string]string{
+	"date-time": time.RFC3339,
+	"date":      "2006-01-02",
+}
+
+// UnaryServerInterceptor rejects requests breaking the rules with InvalidArgument and a BadRequest detail.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		violations := validateMessage(message.ProtoReflect(), "")
+		if len(violations) > 0 {
+			return nil, InvalidArgumentError(violations)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor validates every message received on a stream, including the request of a server-streaming call.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: ss})
+	}
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	message, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	if violations := validateMessage(message.ProtoReflect(), ""); len(violations) > 0 {
+		return InvalidArgumentError(violations)
+	}
+	return nil
+}
+
+// InvalidArgumentError is the error returned for violated rules, also used by the services for checks
+// that cannot be declared in the proto files.
+func InvalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) error {
+	st := status.New(codes.InvalidArgument, "Niepoprawne dane wejściowe")
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func validateMessage(message protoreflect.Message, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := prefix + string(field.Name())
+
+		// Unknown enum numbers are rejected for every field, with or without rules.
+		if field.Kind() == protoreflect.EnumKind && !field.IsList() {
+			number := message.Get(field).Enum()
+			if field.Enum().Values().ByNumber(number) == nil {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{
+					Field:       name,
+					Description: fmt.Sprintf("Nieznana wartość: %d", number),
+				})
+			}
+		}
+
+		rules, _ := proto.GetExtension(field.Options(), E_Rules).(*FieldRules)
+		if rules != nil {
+			for _, description := range validateField(message, field, rules) {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{
+					Field:       name,
+					Description: description,
+				})
+			}
+		}
+
+		if field.Kind() == protoreflect.MessageKind && !field.IsMap() && message.Has(field) {
+			if field.IsList() {
+				list := message.Get(field).List()
+				for j := 0; j < list.Len(); j++ {
+					violations = append(violations, validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", name, j))...)
+				}
+			} else {
+				violations = append(violations, validateMessage(message.Get(field).Message(), name+".")...)
+			}
+		}
+	}
+
+	return violations
+}
+
+func validateField(message protoreflect.Message, field protoreflect.FieldDescriptor, rules *FieldRules) []string {
+	if field.IsList() {
+		list := message.Get(field).List()
+		if rules.Required && list.Len() == 0 {
+			return []string{"Lista nie może być pusta"}
+		}
+		if rules.MaxItems != nil && uint32(list.Len()) > rules.GetMaxItems() {
+			return []string{fmt.Sprintf("Lista nie może zawierać więcej niż %d elementów", rules.GetMaxItems())}
+		}
+		var descriptions []string
+		seen := map[interface{}]int{}
+		for i := 0; i < list.Len(); i++ {
+			for _, description := range validateValue(field, list.Get(i), rules) {
+				descriptions = append(descriptions, fmt.Sprintf("Element %d: %s", i, description))
+			}
+			if rules.Unique && field.Kind() != protoreflect.MessageKind {
+				value := list.Get(i).Interface()
+				if first, ok := seen[value]; ok {
+					descriptions = append(descriptions, fmt.Sprintf("Element %d powtarza element %d", i, first))
+				} else {
+					seen[value] = i
+				}
+			}
+		}
+		return descriptions
+	}
+
+	if rules.Required && !message.Has(field) {
+		return []string{"Pole jest wymagane"}
+	}
+	// An optional field left out keeps its current value, so there is nothing to check.
+	if field.HasPresence() && !message.Has(field) {
+		return nil
+	}
+
+	return validateValue(field, message.Get(field), rules)
+}
+
+func validateValue(field protoreflect.FieldDescriptor, value protoreflect.Value, rules *FieldRules) []string {
+	var descriptions []string
+
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		text := value.String()
+		if rules.Required && strings.TrimSpace(text) == "" {
+			descriptions = append(descriptions, "Pole nie może być puste")
+		}
+		if rules.MaxLen != nil && uint32(utf8.RuneCountInString(text)) > rules.GetMaxLen() {
+			descriptions = append(descriptions, fmt.Sprintf("Długość nie może przekraczać %d znaków", rules.GetMaxLen()))
+		}
+		if len(rules.In) > 0 && text != "" && !contains(rules.In, text) {
+			descriptions = append(descriptions, fmt.Sprintf("Dozwolone wartości: %s", strings.Join(rules.In, ", ")))
+		}
+		if layout, ok := dateFormats[rules.Format]; ok && text != "" {
+			if _, err := time.Parse(layout, text); err != nil {
+				descriptions = append(descriptions, fmt.Sprintf("Niepoprawny format daty, oczekiwano formatu %s", rules.Format))
+			}
+		}
+
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		descriptions = append(descriptions, validateNumber(value.Int(), rules)...)
+
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		descriptions = append(descriptions, validateNumber(int64(value.Uint()), rules)...)
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		descriptions = append(descriptions, validateFloat(value.Float(), rules)...)
+
+	case protoreflect.EnumKind:
+		if rules.Required && value.Enum() == 0 {
+			descriptions = append(descriptions, "Pole jest wymagane")
+		}
+	}
+
+	return descriptions
+}
+
+func validateNumber(number int64, rules *FieldRules) []string {
+	var descriptions []string
+	if rules.Min != nil && number < rules.GetMin() {
+		descriptions = append(descriptions, fmt.Sprintf("Wartość musi być większa lub równa %d", rules.GetMin()))
+	}
+	if rules.Max != nil && number > rules.GetMax() {
+		descriptions = append(descriptions, fmt.Sprintf("Wartość musi być mniejsza lub równa %d", rules.GetMax()))
+	}
+	return descriptions
+}
+
+func validateFloat(number float64, rules *FieldRules) []string {
+	var descriptions []string
+	if rules.Min != nil && number < float64(rules.GetMin()) {
+		descriptions = append(descriptions, fmt.Sprintf("Wartość musi być większa lub równa %d", rules.GetMin()))
+	}
+	if rules.Max != nil && number > float64(rules.GetMax()) {
+		descriptions = append(descriptions, fmt.Sprintf("Wartość musi być mniejsza lub równa %d", rules.GetMax()))
+	}
+	return descriptions
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}